@@ -0,0 +1,289 @@
+(function() {
+    const container = document.getElementById('docs-operations');
+    const nav = document.getElementById('docs-nav');
+    const schemasContainer = document.getElementById('docs-schemas');
+
+    if (!container) return;
+
+    // Crée un élément avec ses attributs et ses enfants (texte ou éléments), sans innerHTML
+    function el(tag, attrs, children) {
+        const node = document.createElement(tag);
+        Object.entries(attrs || {}).forEach(([name, value]) => {
+            if (name === 'className') {
+                node.className = value;
+            } else if (name.startsWith('on')) {
+                node.addEventListener(name.slice(2), value);
+            } else {
+                node.setAttribute(name, value);
+            }
+        });
+        (children || []).forEach(child => {
+            if (child === null || child === undefined) return;
+            node.appendChild(typeof child === 'string' ? document.createTextNode(child) : child);
+        });
+        return node;
+    }
+
+    // Nom du schéma référencé par un $ref (#/components/schemas/Driver -> Driver)
+    function refName(ref) {
+        return ref.split('/').pop();
+    }
+
+    // Représentation courte d'un schéma (lien vers le schéma référencé, array<...>, map<...>)
+    function schemaLabel(schema) {
+        if (!schema) return document.createTextNode('any');
+        if (schema.$ref) {
+            const name = refName(schema.$ref);
+            return el('a', { href: '#schema-' + name, className: 'docs-ref' }, [name]);
+        }
+        if (schema.type === 'array') {
+            return el('span', {}, ['array<', schemaLabel(schema.items), '>']);
+        }
+        if (schema.type === 'object' && schema.additionalProperties) {
+            return el('span', {}, ['map<string, ', schemaLabel(schema.additionalProperties), '>']);
+        }
+        let label = schema.type || 'any';
+        if (schema.enum) label += ' (' + schema.enum.join(' | ') + ')';
+        return document.createTextNode(label);
+    }
+
+    // Champ de saisie d'un paramètre (liste déroulante pour les valeurs énumérées)
+    function paramInput(param) {
+        const schema = param.schema || {};
+        const attrs = { name: param.name, 'data-in': param.in || 'formData' };
+        if (schema.enum) {
+            const options = [el('option', { value: '' }, ['—'])];
+            schema.enum.forEach(value => {
+                const option = el('option', { value: String(value) }, [String(value)]);
+                if (schema.default !== undefined && String(schema.default) === String(value)) {
+                    option.selected = true;
+                }
+                options.push(option);
+            });
+            return el('select', attrs, options);
+        }
+        attrs.type = 'text';
+        attrs.placeholder = schema.default !== undefined ? String(schema.default) : '';
+        if (schema.type === 'array') {
+            // Paramètre répétable : valeurs séparées par des virgules
+            attrs['data-repeated'] = 'true';
+            attrs.placeholder = 'value1, value2';
+        }
+        return el('input', attrs);
+    }
+
+    // Tableau des paramètres d'une opération (nom, emplacement, type, description, valeur à essayer)
+    function paramsTable(params, location) {
+        const rows = params.map(param => el('tr', {}, [
+            el('td', {}, [el('code', {}, [param.name]), param.required ? el('span', { className: 'docs-required' }, [' *']) : null]),
+            el('td', {}, [param.in || location]),
+            el('td', {}, [schemaLabel(param.schema)]),
+            el('td', {}, [param.description || '']),
+            el('td', {}, [paramInput(param)])
+        ]));
+        return el('table', { className: 'docs-table' }, [
+            el('thead', {}, [el('tr', {}, ['Name', 'In', 'Type', 'Description', 'Value'].map(h => el('th', {}, [h])))]),
+            el('tbody', {}, rows)
+        ]);
+    }
+
+    // Liste des réponses documentées (code, description, formats et schéma)
+    function responsesList(responses) {
+        return el('ul', { className: 'docs-responses' }, Object.entries(responses).map(([code, response]) => {
+            const formats = Object.entries(response.content || {}).map(([type, media]) =>
+                el('span', { className: 'docs-media' }, [type + ': ', schemaLabel(media.schema)]));
+            return el('li', {}, [
+                el('span', { className: 'docs-status docs-status-' + code[0] }, [code]),
+                ' ' + (response.description || ''),
+                formats.length ? el('div', {}, formats) : null
+            ]);
+        }));
+    }
+
+    // Construit l'URL d'essai à partir du chemin et des valeurs saisies
+    function buildRequestURL(path, form) {
+        const query = new URLSearchParams();
+        let url = path;
+        form.querySelectorAll('[data-in="path"], [data-in="query"]').forEach(input => {
+            if (input.dataset.in === 'path') {
+                url = url.replace('{' + input.name + '}', encodeURIComponent(input.value));
+            } else if (input.dataset.repeated) {
+                input.value.split(',').map(value => value.trim()).filter(Boolean)
+                    .forEach(value => query.append(input.name, value));
+            } else if (input.value !== '') {
+                query.append(input.name, input.value);
+            }
+        });
+        const search = query.toString();
+        return search ? url + '?' + search : url;
+    }
+
+    // Exécute la requête d'essai et affiche le statut et le corps de la réponse
+    function tryOperation(method, path, form, output) {
+        const url = buildRequestURL(path, form);
+        const options = { method: method.toUpperCase(), redirect: 'manual' };
+        const body = new URLSearchParams();
+        form.querySelectorAll('[data-in="formData"]').forEach(input => {
+            if (input.value !== '') body.append(input.name, input.value);
+        });
+        options.headers = {};
+        if (options.method !== 'GET') {
+            // Jeton CSRF de la page, sauf valeur saisie dans le champ X-CSRF-Token
+            const meta = document.querySelector('meta[name="csrf-token"]');
+            if (meta) options.headers['X-CSRF-Token'] = meta.content;
+        }
+        form.querySelectorAll('[data-in="header"]').forEach(input => {
+            if (input.value !== '') options.headers[input.name] = input.value;
+        });
+        const jsonBody = form.querySelector('[data-in="body"]');
+        if (jsonBody) {
+            options.headers['Content-Type'] = 'application/json';
+            options.body = jsonBody.value;
+        } else if (options.method !== 'GET') {
+            options.body = body;
+        }
+
+        output.replaceChildren(el('p', {}, [options.method + ' ' + url]));
+        fetch(url, options).then(response => {
+            if (response.type === 'opaqueredirect') {
+                output.appendChild(el('p', {}, ['303 See Other (redirect)']));
+                return;
+            }
+            const type = response.headers.get('Content-Type') || '';
+            return response.text().then(text => {
+                if (type.includes('application/json')) {
+                    try {
+                        text = JSON.stringify(JSON.parse(text), null, 2);
+                    } catch (e) {
+                        // Corps non JSON malgré l'en-tête : afficher tel quel
+                    }
+                }
+                if (text.length > 20000) text = text.slice(0, 20000) + '\n…';
+                output.appendChild(el('p', {}, [response.status + ' ' + response.statusText + ' — ' + type]));
+                output.appendChild(el('pre', {}, [text]));
+            });
+        }).catch(error => {
+            output.appendChild(el('p', { className: 'docs-error' }, ['Request failed: ' + error.message]));
+        });
+    }
+
+    // Carte dépliable d'une opération (méthode, chemin, paramètres, corps, réponses, essai)
+    function operationCard(method, path, operation) {
+        const form = el('form', { className: 'docs-try' }, []);
+        const output = el('div', { className: 'docs-output' }, []);
+        const content = (operation.requestBody && operation.requestBody.content) || {};
+        const body = content['application/x-www-form-urlencoded'];
+
+        if (operation.parameters && operation.parameters.length) {
+            form.appendChild(el('h4', {}, ['Parameters']));
+            form.appendChild(paramsTable(operation.parameters, 'query'));
+        }
+        if (body) {
+            const schema = body.schema;
+            const required = schema.required || [];
+            const fields = Object.entries(schema.properties).map(([name, fieldSchema]) =>
+                ({ name: name, in: 'formData', required: required.includes(name), schema: fieldSchema, description: '' }));
+            form.appendChild(el('h4', {}, ['Form body (application/x-www-form-urlencoded)']));
+            form.appendChild(paramsTable(fields, 'formData'));
+        }
+        if (content['application/json']) {
+            form.appendChild(el('h4', {}, ['Body (application/json): ', schemaLabel(content['application/json'].schema)]));
+            form.appendChild(el('textarea', { 'data-in': 'body', rows: '6', className: 'docs-body', placeholder: '{ }' }, []));
+        }
+        form.appendChild(el('button', { type: 'submit' }, ['Try it']));
+        form.addEventListener('submit', event => {
+            event.preventDefault();
+            tryOperation(method, path, form, output);
+        });
+
+        return el('details', { className: 'docs-operation', id: operation.operationId }, [
+            el('summary', {}, [
+                el('span', { className: 'docs-method docs-method-' + method }, [method.toUpperCase()]),
+                el('code', { className: 'docs-path' }, [path]),
+                el('span', { className: 'docs-summary' }, [operation.summary || ''])
+            ]),
+            operation.description ? el('p', { className: 'docs-description' }, [operation.description]) : null,
+            form,
+            el('h4', {}, ['Responses']),
+            responsesList(operation.responses || {}),
+            output
+        ]);
+    }
+
+    // Section d'un schéma (propriétés, types et champs requis)
+    function schemaSection(name, schema) {
+        const required = schema.required || [];
+        const rows = Object.entries(schema.properties || {}).map(([property, propertySchema]) => el('tr', {}, [
+            el('td', {}, [el('code', {}, [property]), required.includes(property) ? el('span', { className: 'docs-required' }, [' *']) : null]),
+            el('td', {}, [schemaLabel(propertySchema)])
+        ]));
+        return el('div', { className: 'docs-schema', id: 'schema-' + name }, [
+            el('h3', {}, [name]),
+            schema.description ? el('p', {}, [schema.description]) : null,
+            el('table', { className: 'docs-table' }, [
+                el('thead', {}, [el('tr', {}, [el('th', {}, ['Property']), el('th', {}, ['Type'])])]),
+                el('tbody', {}, rows)
+            ])
+        ]);
+    }
+
+    // Affiche la spécification : opérations groupées par tag, navigation et schémas
+    function render(spec) {
+        const description = document.getElementById('docs-description');
+        if (description && spec.info) {
+            description.textContent = spec.info.title + ' ' + spec.info.version + ' — ' + spec.info.description;
+        }
+
+        const groups = {};
+        (spec.tags || []).forEach(tag => { groups[tag.name] = { tag: tag, cards: [], links: [] }; });
+        Object.entries(spec.paths).forEach(([path, item]) => {
+            Object.entries(item).forEach(([method, operation]) => {
+                const tagName = (operation.tags || ['other'])[0];
+                if (!groups[tagName]) groups[tagName] = { tag: { name: tagName }, cards: [], links: [] };
+                groups[tagName].cards.push(operationCard(method, path, operation));
+                groups[tagName].links.push(el('li', {}, [
+                    el('a', { href: '#' + operation.operationId }, [method.toUpperCase() + ' ' + path])
+                ]));
+            });
+        });
+
+        container.replaceChildren();
+        nav.replaceChildren();
+        Object.values(groups).forEach(group => {
+            container.appendChild(el('section', { className: 'docs-group', id: 'tag-' + group.tag.name }, [
+                el('h2', {}, [group.tag.name]),
+                group.tag.description ? el('p', { className: 'docs-description' }, [group.tag.description]) : null
+            ].concat(group.cards)));
+            nav.appendChild(el('div', {}, [
+                el('h4', {}, [el('a', { href: '#tag-' + group.tag.name }, [group.tag.name])]),
+                el('ul', {}, group.links)
+            ]));
+        });
+
+        schemasContainer.replaceChildren();
+        Object.entries((spec.components || {}).schemas || {}).forEach(([name, schema]) => {
+            schemasContainer.appendChild(schemaSection(name, schema));
+        });
+
+        // Ouvrir l'opération ciblée par l'ancre de l'URL
+        if (location.hash) {
+            const target = document.getElementById(location.hash.slice(1));
+            if (target && target.tagName === 'DETAILS') target.open = true;
+        }
+    }
+
+    // Ouvrir une opération quand on la sélectionne dans la navigation
+    nav.addEventListener('click', event => {
+        const link = event.target.closest('a');
+        if (!link) return;
+        const target = document.getElementById(link.getAttribute('href').slice(1));
+        if (target && target.tagName === 'DETAILS') target.open = true;
+    });
+
+    fetch(container.dataset.spec)
+        .then(response => response.json())
+        .then(render)
+        .catch(error => {
+            container.replaceChildren(el('p', { className: 'docs-error' }, ['Unable to load the specification: ' + error.message]));
+        });
+})();
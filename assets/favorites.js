@@ -0,0 +1,73 @@
+(function() {
+    const lists = document.querySelectorAll('.favorites-grid.sortable');
+    if (!lists.length) return;
+
+    // Jeton CSRF de la page (exigé par le serveur pour toute requête POST)
+    function csrfToken() {
+        const meta = document.querySelector('meta[name="csrf-token"]');
+        return meta ? meta.content : '';
+    }
+
+    // Envoie la nouvelle position d'un élément (1 = en tête) ; recharge la page pour afficher l'ordre enregistré
+    function saveMove(list, item, position) {
+        const body = new URLSearchParams({
+            collection: list.dataset.collection,
+            type: item.dataset.type,
+            id: item.dataset.id,
+            position: String(position)
+        });
+        fetch('/favorites/items/move', {
+            method: 'POST',
+            headers: { 'Accept': 'application/json', 'X-CSRF-Token': csrfToken() },
+            body: body
+        })
+            .catch(() => null)
+            .then(() => window.location.reload());
+    }
+
+    // Élément sous le pointeur devant lequel insérer l'élément déplacé (null : en fin de liste)
+    function itemAfter(list, x, y) {
+        const items = Array.from(list.querySelectorAll('.favorite-item:not(.dragging)'));
+        return items.find(item => {
+            const box = item.getBoundingClientRect();
+            return y < box.top + box.height / 2 || (y < box.bottom && x < box.left + box.width / 2);
+        }) || null;
+    }
+
+    lists.forEach(list => {
+        let dragged = null;
+        let startPosition = 0;
+
+        list.addEventListener('dragstart', event => {
+            dragged = event.target.closest('.favorite-item');
+            if (!dragged) return;
+            startPosition = Array.from(list.children).indexOf(dragged) + 1;
+            dragged.classList.add('dragging');
+            event.dataTransfer.effectAllowed = 'move';
+            event.dataTransfer.setData('text/plain', dragged.dataset.id);
+        });
+
+        list.addEventListener('dragover', event => {
+            if (!dragged) return;
+            event.preventDefault();
+            const next = itemAfter(list, event.clientX, event.clientY);
+            if (next !== dragged.nextElementSibling) {
+                list.insertBefore(dragged, next);
+            }
+        });
+
+        list.addEventListener('drop', event => {
+            if (dragged) event.preventDefault();
+        });
+
+        list.addEventListener('dragend', () => {
+            if (!dragged) return;
+            dragged.classList.remove('dragging');
+            const position = Array.from(list.children).indexOf(dragged) + 1;
+            if (position !== startPosition) {
+                saveMove(list, dragged, position);
+            }
+            dragged = null;
+        });
+    });
+})();
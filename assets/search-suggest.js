@@ -0,0 +1,135 @@
+(function() {
+    const forms = document.querySelectorAll('.search-box form[action="/search"]');
+    if (!forms.length || !window.fetch) return;
+
+    const minLength = 2;
+    const delay = 150;
+    const kindLabels = { driver: 'Driver', constructor: 'Team' };
+
+    forms.forEach((form, formIndex) => {
+        const input = form.querySelector('input[name="q"]');
+        const seasonInput = form.querySelector('input[name="season"]');
+        if (!input) return;
+
+        // Liste des suggestions, reliée au champ de recherche (combobox ARIA)
+        const list = document.createElement('ul');
+        list.id = 'search-suggest-' + formIndex;
+        list.className = 'search-suggest';
+        list.setAttribute('role', 'listbox');
+        list.hidden = true;
+        form.classList.add('search-suggest-form');
+        form.appendChild(list);
+
+        input.setAttribute('role', 'combobox');
+        input.setAttribute('autocomplete', 'off');
+        input.setAttribute('aria-autocomplete', 'list');
+        input.setAttribute('aria-controls', list.id);
+        input.setAttribute('aria-expanded', 'false');
+
+        let suggestions = [];
+        let active = -1;
+        let timer = null;
+        let controller = null;
+
+        function close() {
+            list.hidden = true;
+            list.replaceChildren();
+            suggestions = [];
+            active = -1;
+            input.setAttribute('aria-expanded', 'false');
+            input.removeAttribute('aria-activedescendant');
+        }
+
+        // Met en évidence la suggestion choisie au clavier (-1 : aucune)
+        function highlight(index) {
+            active = index;
+            Array.from(list.children).forEach((item, i) => {
+                item.setAttribute('aria-selected', i === index ? 'true' : 'false');
+            });
+            if (index >= 0) {
+                input.setAttribute('aria-activedescendant', list.children[index].id);
+            } else {
+                input.removeAttribute('aria-activedescendant');
+            }
+        }
+
+        // Affiche les suggestions (textContent uniquement : aucune donnée interprétée comme du HTML)
+        function render(items) {
+            suggestions = items;
+            list.replaceChildren();
+            items.forEach((suggestion, index) => {
+                const item = document.createElement('li');
+                item.id = list.id + '-' + index;
+                item.setAttribute('role', 'option');
+                item.setAttribute('aria-selected', 'false');
+
+                const label = document.createElement('span');
+                label.className = 'search-suggest-label';
+                label.textContent = suggestion.label;
+                item.appendChild(label);
+
+                const detail = document.createElement('span');
+                detail.className = 'search-suggest-detail';
+                detail.textContent = [kindLabels[suggestion.kind] || suggestion.kind, suggestion.detail].filter(Boolean).join(' · ');
+                item.appendChild(detail);
+
+                // mousedown plutôt que click : le champ ne perd pas le focus avant la navigation
+                item.addEventListener('mousedown', event => {
+                    event.preventDefault();
+                    window.location.href = suggestion.url;
+                });
+                list.appendChild(item);
+            });
+            list.hidden = items.length === 0;
+            input.setAttribute('aria-expanded', items.length ? 'true' : 'false');
+            highlight(-1);
+        }
+
+        // Interroge l'API (la requête précédente encore en cours est annulée)
+        function load(query) {
+            if (controller) controller.abort();
+            controller = window.AbortController ? new AbortController() : null;
+            const params = new URLSearchParams({ q: query });
+            if (seasonInput && seasonInput.value) params.set('season', seasonInput.value);
+            fetch('/api/search/suggest?' + params.toString(), {
+                headers: { 'Accept': 'application/json' },
+                signal: controller ? controller.signal : undefined
+            })
+                .then(response => response.ok ? response.json() : { suggestions: [] })
+                .then(data => {
+                    if (input.value.trim() === query) render(data.suggestions || []);
+                })
+                .catch(() => null);
+        }
+
+        input.addEventListener('input', () => {
+            clearTimeout(timer);
+            const query = input.value.trim();
+            if (query.length < minLength) {
+                if (controller) controller.abort();
+                close();
+                return;
+            }
+            timer = setTimeout(() => load(query), delay);
+        });
+
+        input.addEventListener('keydown', event => {
+            if (list.hidden) return;
+            if (event.key === 'ArrowDown') {
+                event.preventDefault();
+                highlight((active + 1) % suggestions.length);
+            } else if (event.key === 'ArrowUp') {
+                event.preventDefault();
+                highlight(active <= 0 ? suggestions.length - 1 : active - 1);
+            } else if (event.key === 'Enter' && active >= 0) {
+                // Entrée sans suggestion choisie : le formulaire est envoyé vers /search comme avant
+                event.preventDefault();
+                window.location.href = suggestions[active].url;
+            } else if (event.key === 'Escape') {
+                close();
+            }
+        });
+
+        input.addEventListener('blur', close);
+    });
+})();
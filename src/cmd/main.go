@@ -2,16 +2,73 @@ package main
 
 import (
 	"f1-app/routers"
+	"f1-app/services"
 	"f1-app/templates"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 )
 
 func main() {
 	// Chargement des templates au démarrage (fail fast si besoin dans Load()).
 	templates.Load()
 
+	// Choix de la source de données : intégrée par défaut, API Ergast si F1_DATA_SOURCE=ergast.
+	if os.Getenv("F1_DATA_SOURCE") == "ergast" {
+		source := services.NewErgastSource(os.Getenv("F1_ERGAST_URL"))
+		services.UseDriverSource(source)
+		services.UseRaceSource(source)
+		services.UseResultSource(source)
+		fmt.Println("Source de données : API Ergast")
+	}
+
+	// Alias d'écuries renommées (ex. F1_CONSTRUCTOR_ALIASES="sauber=audi"), ajoutés à la table intégrée.
+	if value := os.Getenv("F1_CONSTRUCTOR_ALIASES"); value != "" {
+		aliases, err := services.ParseConstructorAliases(value)
+		if err != nil {
+			log.Fatalf("Erreur configuration des alias d'écuries : %s\n", err.Error())
+		}
+		services.AddConstructorAliases(aliases)
+	}
+
+	// Vérification de l'intégrité des données : pilotes rattachés à une écurie inconnue (journalisés, non bloquants).
+	issues, err := services.ValidateDataIntegrity()
+	if err != nil {
+		log.Printf("Vérification de l'intégrité des données impossible : %s\n", err.Error())
+	}
+	for _, issue := range issues {
+		log.Printf("Intégrité des données : %s\n", issue)
+	}
+
+	// Tailles de page acceptées par les listes (ex. F1_PAGE_SIZES="10,25,50,100", la première est la taille par défaut).
+	if value := os.Getenv("F1_PAGE_SIZES"); value != "" {
+		sizes, err := services.ParsePageSizes(value)
+		if err != nil {
+			log.Fatalf("Erreur configuration des tailles de page : %s\n", err.Error())
+		}
+		services.UsePageSizes(sizes)
+	}
+
+	// Choix du stockage des favoris et des comptes : fichiers JSON par défaut, base SQLite si F1_FAVORITES_STORE=sqlite.
+	storeKind := os.Getenv("F1_FAVORITES_STORE")
+	store, err := services.OpenFavoritesStore(storeKind, os.Getenv("F1_FAVORITES_PATH"))
+	if err != nil {
+		log.Fatalf("Erreur ouverture stockage des favoris : %s\n", err.Error())
+	}
+	services.UseFavoritesStore(store)
+	services.UseUserStore(services.OpenUserStore(store))
+	if storeKind == services.FavoritesStoreSQLite {
+		fmt.Println("Stockage des favoris : SQLite")
+	}
+
+	// Reprise des favoris globaux d'avant les comptes par un compte désigné (ex. F1_SHARED_FAVORITES_OWNER="alice").
+	if username := os.Getenv("F1_SHARED_FAVORITES_OWNER"); username != "" {
+		if err := services.MigrateSharedFavorites(username); err != nil {
+			log.Fatalf("Erreur reprise des favoris globaux : %s\n", err.Error())
+		}
+	}
+
 	// Construction du routeur principal (toutes les routes sont enregistrées dedans)
 	mux := routers.MainRouter()
 
@@ -19,8 +76,7 @@ func main() {
 	addr := "localhost:8080"
 	fmt.Printf("Serveur prêt sur http://%s\n", addr)
 	// IMPORTANT : on passe bien "mux" à ListenAndServe pour utiliser NOTRE routeur,
-	err := http.ListenAndServe(addr, mux)
-	if err != nil {
+	if err := http.ListenAndServe(addr, mux); err != nil {
 		// En cas d'erreur au lancement, on log et on sort.
 		log.Fatalf("Erreur lancement serveur : %s\n", err.Error())
 	}
@@ -0,0 +1,239 @@
+package controllers
+
+import (
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"f1-app/templates"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Cookies d'identification du visiteur.
+const (
+	sessionCookieName = "f1_session"
+	visitorCookieName = "f1_visitor"
+	// visitorCookieMaxAge est la durée de vie du cookie anonyme (un an).
+	visitorCookieMaxAge = 365 * 24 * 60 * 60
+)
+
+// SessionMiddleware
+// -----------------
+// Objectif :
+//   - Identifier le visiteur de chaque requête : compte connecté (cookie de session) et/ou visiteur anonyme.
+//   - Attribuer un cookie anonyme aux nouveaux visiteurs non connectés (favoris propres à ce navigateur).
+//   - Transmettre le visiteur aux handlers via le contexte de la requête (services.ViewerFromContext).
+//   - Ignorer les fichiers statiques.
+func SessionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/static/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Étape 1 : Retrouver le compte de la session (cookie effacé si la session n'est plus valide).
+		viewer := &models.Viewer{}
+		if cookie, err := r.Cookie(sessionCookieName); err == nil {
+			viewer.User = services.SessionUser(cookie.Value)
+			if viewer.User == nil {
+				clearCookie(w, r, sessionCookieName)
+			}
+		}
+
+		// Étape 2 : Retrouver ou attribuer l'identifiant anonyme.
+		if cookie, err := r.Cookie(visitorCookieName); err == nil && services.IsValidAnonymousID(cookie.Value) {
+			viewer.AnonymousID = cookie.Value
+		} else if viewer.User == nil {
+			viewer.AnonymousID = services.NewAnonymousID()
+			setCookie(w, r, visitorCookieName, viewer.AnonymousID, visitorCookieMaxAge)
+		}
+
+		// Étape 3 : Poursuivre avec le visiteur dans le contexte.
+		next.ServeHTTP(w, r.WithContext(services.WithViewer(r.Context(), viewer)))
+	})
+}
+
+// AccountHandler
+// --------------
+// Objectif :
+//   - Afficher la page du compte : formulaires de connexion et d'inscription, ou compte connecté et déconnexion.
+func AccountHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Rendre la page du compte.
+	renderAccountPage(w, r, http.StatusOK, "", "")
+}
+
+// RegisterHandler
+// ---------------
+// Objectif :
+//   - Créer un compte à partir du formulaire (username, password) puis ouvrir une session.
+//   - Rattacher au compte les favoris ajoutés en tant que visiteur anonyme.
+//   - En cas d'erreur de saisie ou de nom déjà pris : réafficher la page du compte avec le message.
+func RegisterHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Créer le compte.
+	username := r.FormValue("username")
+	user, status, err := services.RegisterUser(username, r.FormValue("password"))
+	if err != nil {
+		if status == http.StatusInternalServerError {
+			log.Printf("erreur création compte: %v", err)
+			helpers.RedirectToError(w, r, status, "Impossible de créer le compte")
+			return
+		}
+		renderAccountPage(w, r, status, err.Error(), username)
+		return
+	}
+
+	// Étape 3 : Ouvrir la session et rediriger vers la page du compte.
+	signIn(w, r, user)
+}
+
+// LoginHandler
+// ------------
+// Objectif :
+//   - Vérifier les identifiants du formulaire (username, password) puis ouvrir une session.
+//   - Rattacher au compte les favoris ajoutés en tant que visiteur anonyme.
+//   - En cas d'identifiants incorrects : réafficher la page du compte (401).
+func LoginHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Vérifier les identifiants.
+	username := r.FormValue("username")
+	user, status, err := services.AuthenticateUser(username, r.FormValue("password"))
+	if err != nil {
+		if status == http.StatusInternalServerError {
+			log.Printf("erreur connexion: %v", err)
+			helpers.RedirectToError(w, r, status, "Impossible de se connecter")
+			return
+		}
+		renderAccountPage(w, r, status, err.Error(), username)
+		return
+	}
+
+	// Étape 3 : Ouvrir la session et rediriger vers la page du compte.
+	signIn(w, r, user)
+}
+
+// LogoutHandler
+// -------------
+// Objectif :
+//   - Fermer la session en cours, effacer son cookie et renouveler le jeton CSRF.
+//   - Rediriger vers l'accueil.
+func LogoutHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Supprimer la session et son cookie.
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		if err := services.EndSession(cookie.Value); err != nil {
+			log.Printf("erreur déconnexion: %v", err)
+		}
+	}
+	clearCookie(w, r, sessionCookieName)
+	rotateCSRFToken(w, r)
+
+	// Étape 3 : Rediriger vers l'accueil.
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// signIn
+// ------
+// Objectif :
+//   - Ouvrir une session pour le compte et poser son cookie (avec un nouveau jeton CSRF).
+//   - Fusionner les favoris du visiteur anonyme dans ceux du compte, puis effacer le cookie anonyme.
+//   - Rediriger vers la page du compte.
+func signIn(w http.ResponseWriter, r *http.Request, user *models.User) {
+	token, expires, err := services.StartSession(user.ID)
+	if err != nil {
+		log.Printf("erreur ouverture session: %v", err)
+		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Impossible d'ouvrir la session")
+		return
+	}
+	setCookie(w, r, sessionCookieName, token, int(time.Until(expires).Seconds()))
+	rotateCSRFToken(w, r)
+
+	viewer := services.ViewerFromContext(r.Context())
+	if viewer.AnonymousID != "" {
+		anonymousOwner := services.AnonymousFavoritesOwner(viewer.AnonymousID)
+		if err := services.MergeFavorites(anonymousOwner, services.UserFavoritesOwner(user.ID)); err != nil {
+			log.Printf("erreur fusion des favoris: %v", err)
+		}
+		clearCookie(w, r, visitorCookieName)
+	}
+	http.Redirect(w, r, "/account", http.StatusSeeOther)
+}
+
+// renderAccountPage
+// Rend la page du compte avec un éventuel message d'erreur et le nom saisi.
+func renderAccountPage(w http.ResponseWriter, r *http.Request, status int, message, username string) {
+	data := &models.PageData{
+		Title:       "My Account",
+		CurrentPage: "account",
+		Error:       message,
+		Data: map[string]interface{}{
+			"user":     services.ViewerFromContext(r.Context()).User,
+			"username": username,
+			"season":   services.ResolveSeason(r.URL.Query().Get("season")),
+			"seasons":  services.GetSeasons(),
+		},
+	}
+	templates.RenderTemplateWithStatus(w, r, "account", data, status)
+}
+
+// favoritesOwner
+// Retourne la clé des favoris du visiteur de la requête (compte connecté ou cookie anonyme).
+func favoritesOwner(r *http.Request) string {
+	return services.FavoritesOwner(services.ViewerFromContext(r.Context()))
+}
+
+// favoritesActor
+// Retourne le nom du compte connecté, enregistré comme auteur dans le journal des favoris (vide pour un visiteur anonyme).
+func favoritesActor(r *http.Request) string {
+	if user := services.ViewerFromContext(r.Context()).User; user != nil {
+		return user.Username
+	}
+	return ""
+}
+
+// setCookie
+// Pose un cookie HttpOnly SameSite=Lax sur tout le site (Secure en HTTPS).
+func setCookie(w http.ResponseWriter, r *http.Request, name, value string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// clearCookie
+// Efface un cookie posé par setCookie.
+func clearCookie(w http.ResponseWriter, r *http.Request, name string) {
+	setCookie(w, r, name, "", -1)
+}
@@ -0,0 +1,324 @@
+package controllers
+
+import (
+	"errors"
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// apiPrefix est le préfixe des routes de l'API JSON versionnée.
+const apiPrefix = "/api/v1/"
+
+// APIDriversHandler
+// -----------------
+// Objectif :
+//   - Retourner la liste des pilotes en JSON (GET /api/v1/drivers).
+//   - Accepter les mêmes paramètres que la page /drivers : season, team, nationality, driverType, decade, rookie (répétables),
+//     ageMin, ageMax, sort, order, page, perPage, et cursor (pagination par curseur, remplace page).
+//   - Inclure les facettes des filtres (valeurs et nombre de pilotes) et les métadonnées de pagination dans la réponse,
+//     et les liens first, prev, next et last dans l'en-tête Link.
+func APIDriversHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Récupérer les paramètres depuis l'URL.
+	query := r.URL.Query()
+	season := services.ResolveSeason(query.Get("season"))
+	filters, err := driverFiltersFromQuery(query)
+	if err != nil {
+		helpers.WriteJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Étape 3 : Exécuter la requête partagée avec la page HTML.
+	result, status, err := services.QueryDrivers(season, filters, query.Get("sort"), query.Get("order"), pageRequestFromQuery(query))
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur API pilotes:", err)
+		helpers.WriteJSONError(w, status, listErrorMessage(status, season, err, "Impossible de récupérer les pilotes"))
+		return
+	}
+
+	// Étape 4 : Retourner la réponse JSON (liens de pagination dans l'en-tête Link).
+	helpers.WritePaginationHeaders(w, r, result.Pagination)
+	helpers.WriteJSON(w, http.StatusOK, models.DriversResponse{
+		Season:     season,
+		Sort:       result.Sort,
+		Order:      result.Order,
+		Filters:    result.Filters,
+		Facets:     result.Facets,
+		Drivers:    result.Drivers,
+		Pagination: result.Pagination,
+	})
+}
+
+// APIDriverHandler
+// ----------------
+// Objectif :
+//   - Retourner un pilote et son écurie en JSON (GET /api/v1/drivers/{id}?season=).
+//   - Retourner une erreur JSON 404 si le pilote ou la saison n'existe pas.
+func APIDriverHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Extraire l'ID du pilote depuis l'URL.
+	driverID, ok := apiResourceID(r.URL.Path, "drivers")
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusNotFound, "Pilote non trouvé")
+		return
+	}
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+
+	// Étape 3 : Récupérer le pilote et son écurie.
+	driver, team, status, err := services.GetDriverService(season, driverID)
+	if status != http.StatusOK || err != nil {
+		message := helpers.SeasonErrorMessage(status, season, "Impossible de récupérer les pilotes")
+		if errors.Is(err, services.ErrDriverNotFound) {
+			message = "Pilote non trouvé"
+		}
+		helpers.WriteJSONError(w, status, message)
+		return
+	}
+
+	// Étape 4 : Retourner la réponse JSON.
+	helpers.WriteJSON(w, http.StatusOK, models.DriverResponse{
+		Season:      season,
+		Driver:      *driver,
+		Constructor: team,
+	})
+}
+
+// APIConstructorsHandler
+// ----------------------
+// Objectif :
+//   - Retourner la liste des écuries en JSON (GET /api/v1/constructors).
+//   - Accepter les paramètres season, nationality, engine (répétables), sort, order, page, perPage et cursor.
+//   - Inclure les filtres appliqués, les facettes et les métadonnées de pagination dans la réponse,
+//     et les liens first, prev, next et last dans l'en-tête Link.
+func APIConstructorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Récupérer les paramètres depuis l'URL.
+	query := r.URL.Query()
+	season := services.ResolveSeason(query.Get("season"))
+	filters := constructorFiltersFromQuery(query)
+
+	// Étape 3 : Exécuter la requête partagée avec la page HTML.
+	result, status, err := services.QueryConstructors(season, filters, query.Get("sort"), query.Get("order"), pageRequestFromQuery(query))
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur API écuries:", err)
+		helpers.WriteJSONError(w, status, listErrorMessage(status, season, err, "Impossible de récupérer les écuries"))
+		return
+	}
+
+	// Étape 4 : Retourner la réponse JSON (liens de pagination dans l'en-tête Link).
+	helpers.WritePaginationHeaders(w, r, result.Pagination)
+	helpers.WriteJSON(w, http.StatusOK, models.ConstructorsResponse{
+		Season:       season,
+		Sort:         result.Sort,
+		Order:        result.Order,
+		Filters:      result.Filters,
+		Facets:       result.Facets,
+		Constructors: result.Constructors,
+		Pagination:   result.Pagination,
+	})
+}
+
+// APIConstructorHandler
+// ---------------------
+// Objectif :
+//   - Retourner une écurie et ses pilotes en JSON (GET /api/v1/constructors/{id}?season=).
+//   - Retourner une erreur JSON 404 si l'écurie ou la saison n'existe pas.
+func APIConstructorHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Extraire l'ID de l'écurie depuis l'URL.
+	constructorID, ok := apiResourceID(r.URL.Path, "constructors")
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusNotFound, "Écurie non trouvée")
+		return
+	}
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+
+	// Étape 3 : Récupérer l'écurie et ses pilotes.
+	team, drivers, status, err := services.GetConstructorService(season, constructorID)
+	if status != http.StatusOK || err != nil {
+		message := helpers.SeasonErrorMessage(status, season, "Impossible de récupérer les écuries")
+		if errors.Is(err, services.ErrConstructorNotFound) {
+			message = "Écurie non trouvée"
+		}
+		helpers.WriteJSONError(w, status, message)
+		return
+	}
+
+	// Étape 4 : Retourner la réponse JSON.
+	helpers.WriteJSON(w, http.StatusOK, models.ConstructorResponse{
+		Season:      season,
+		Constructor: *team,
+		Drivers:     drivers,
+	})
+}
+
+// APISearchHandler
+// ----------------
+// Objectif :
+//   - Rechercher dans les pilotes et les écuries (GET /api/v1/search?q=&season=), avec la syntaxe de la page de recherche.
+//   - Retourner une erreur JSON 400 si le paramètre q est absent ou mal formé (message et position de l'erreur).
+func APISearchHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Récupérer et valider la requête de recherche.
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, "Paramètre q manquant")
+		return
+	}
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+
+	// Étape 3 : Rechercher les pilotes et écuries correspondants.
+	drivers, constructors, status, err := services.GetSearchService(season, query)
+	var syntaxErr *services.SearchSyntaxError
+	if errors.As(err, &syntaxErr) {
+		helpers.WriteJSONError(w, status, "Requête de recherche invalide : "+syntaxErr.Error())
+		return
+	}
+	if status != http.StatusOK || err != nil {
+		helpers.WriteJSONError(w, status, helpers.SeasonErrorMessage(status, season, "Erreur lors de la recherche"))
+		return
+	}
+
+	// Étape 4 : Retourner la réponse JSON (listes vides plutôt que null).
+	if drivers == nil {
+		drivers = []models.Driver{}
+	}
+	if constructors == nil {
+		constructors = []models.Constructor{}
+	}
+	helpers.WriteJSON(w, http.StatusOK, models.SearchResponse{
+		Season:       season,
+		Query:        query,
+		Drivers:      drivers,
+		Constructors: constructors,
+	})
+}
+
+// APISuggestHandler
+// -----------------
+// Objectif :
+//   - Proposer des pilotes et écuries pendant la saisie (GET /api/search/suggest?q=&season=&limit=).
+//   - Retourner une erreur JSON 400 si le paramètre q est absent ou si limit n'est pas un entier positif.
+func APISuggestHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Récupérer les paramètres depuis l'URL.
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, "Paramètre q manquant")
+		return
+	}
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+
+	// Étape 3 : Chercher les suggestions dans l'index de la saison.
+	suggestions, status, err := services.GetSuggestions(season, query, r.URL.Query().Get("limit"))
+	if errors.Is(err, services.ErrInvalidSuggestLimit) {
+		helpers.WriteJSONError(w, status, err.Error())
+		return
+	}
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur API suggestions:", err)
+		helpers.WriteJSONError(w, status, helpers.SeasonErrorMessage(status, season, "Impossible de récupérer les suggestions"))
+		return
+	}
+
+	// Étape 4 : Retourner la réponse JSON.
+	helpers.WriteJSON(w, http.StatusOK, models.SuggestResponse{
+		Season:      season,
+		Query:       query,
+		Suggestions: suggestions,
+	})
+}
+
+// APIFavoritesHandler
+// -------------------
+// Objectif :
+//   - Retourner les favoris du visiteur (cookie de session ou anonyme) et les fiches correspondantes de la saison (GET /api/v1/favorites).
+//   - Signaler les éléments qui n'existent plus dans aucune saison (orphans).
+func APIFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Charger les favoris pour la saison demandée.
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+	favorites, drivers, constructors, status, err := services.GetFavoritesService(favoritesOwner(r), season)
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur API favoris:", err)
+		helpers.WriteJSONError(w, status, helpers.SeasonErrorMessage(status, season, "Impossible de charger les favoris"))
+		return
+	}
+
+	// Étape 3 : Repérer les éléments qui n'existent plus dans aucune saison (liste vide si la vérification échoue).
+	orphans, err := services.FindOrphanedFavorites(favorites)
+	if err != nil {
+		fmt.Println("Erreur API favoris orphelins:", err)
+	}
+
+	// Étape 4 : Retourner la réponse JSON.
+	helpers.WriteJSON(w, http.StatusOK, models.FavoritesResponse{
+		Season:       season,
+		Favorites:    *favorites,
+		Drivers:      drivers,
+		Constructors: constructors,
+		Orphans:      orphans,
+	})
+}
+
+// APINotFoundHandler
+// Répond une erreur JSON 404 pour toute route inconnue sous /api/.
+func APINotFoundHandler(w http.ResponseWriter, r *http.Request) {
+	helpers.WriteJSONError(w, http.StatusNotFound, "Route API inconnue : "+r.URL.Path)
+}
+
+// apiResourceID
+// Extrait l'identifiant d'une URL /api/v1/{resource}/{id} (ok=false si le chemin ne correspond pas).
+func apiResourceID(path, resource string) (string, bool) {
+	id := strings.TrimPrefix(path, apiPrefix+resource+"/")
+	if id == path || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
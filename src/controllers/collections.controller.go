@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"f1-app/helpers"
+	"f1-app/services"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// CreateCollectionHandler
+// -----------------------
+// Objectif :
+//   - Créer une collection de favoris nommée (paramètre name) pour le visiteur.
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur (nom invalide ou déjà pris).
+func CreateCollectionHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Créer la collection puis rediriger.
+	_, status, err := services.CreateFavoritesCollection(favoritesOwner(r), r.FormValue("name"))
+	finishFavoritesChange(w, r, status, err, "Impossible de créer la collection")
+}
+
+// RenameCollectionHandler
+// -----------------------
+// Objectif :
+//   - Renommer une collection du visiteur (paramètres collection et name).
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur.
+func RenameCollectionHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Renommer la collection puis rediriger.
+	status, err := services.RenameFavoritesCollection(favoritesOwner(r), r.FormValue("collection"), r.FormValue("name"))
+	finishFavoritesChange(w, r, status, err, "Impossible de renommer la collection")
+}
+
+// DeleteCollectionHandler
+// -----------------------
+// Objectif :
+//   - Supprimer une collection du visiteur et ses éléments (la collection par défaut ne peut pas l'être).
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur.
+func DeleteCollectionHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Supprimer la collection puis rediriger.
+	status, err := services.DeleteFavoritesCollection(favoritesOwner(r), r.FormValue("collection"))
+	finishFavoritesChange(w, r, status, err, "Impossible de supprimer la collection")
+}
+
+// MoveFavoriteHandler
+// -------------------
+// Objectif :
+//   - Déplacer un élément dans sa collection (paramètres collection, type, id et position, 1 = en tête).
+//   - Servir les boutons monter/descendre (redirection vers returnUrl) et le glisser-déposer de la page
+//     (requête fetch avec Accept: application/json : réponse 204, ou erreur JSON).
+func MoveFavoriteHandler(w http.ResponseWriter, r *http.Request) {
+	wantsJSON := r.Header.Get("Accept") == "application/json"
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		if wantsJSON {
+			helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+			return
+		}
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Lire la position demandée.
+	position, err := strconv.Atoi(r.FormValue("position"))
+	if err != nil {
+		if wantsJSON {
+			helpers.WriteJSONError(w, http.StatusBadRequest, "Position invalide")
+			return
+		}
+		helpers.RedirectToError(w, r, http.StatusBadRequest, "Position invalide")
+		return
+	}
+
+	// Étape 3 : Déplacer l'élément.
+	status, err := services.MoveFavorite(favoritesOwner(r), r.FormValue("collection"), r.FormValue("type"), r.FormValue("id"), position)
+
+	// Étape 4 : Répondre selon le client (fetch ou formulaire).
+	if wantsJSON {
+		if err != nil {
+			if status == http.StatusInternalServerError {
+				log.Printf("erreur déplacement favori: %v", err)
+				helpers.WriteJSONError(w, status, "Impossible de déplacer le favori")
+				return
+			}
+			helpers.WriteJSONError(w, status, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	finishFavoritesChange(w, r, status, err, "Impossible de déplacer le favori")
+}
+
+// EditFavoriteHandler
+// -------------------
+// Objectif :
+//   - Modifier la note et les tags d'un élément d'une collection (paramètres collection, type, id, note et tags).
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur (note ou tags invalides).
+func EditFavoriteHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Enregistrer la note et les tags puis rediriger.
+	status, err := services.EditFavorite(favoritesOwner(r), r.FormValue("collection"), r.FormValue("type"), r.FormValue("id"),
+		r.FormValue("note"), r.FormValue("tags"))
+	finishFavoritesChange(w, r, status, err, "Impossible de modifier le favori")
+}
+
+// finishFavoritesChange
+// ---------------------
+// Objectif :
+//   - Rediriger vers la page d'origine (returnUrl, / s'il est vide) après une modification réussie,
+//     uniquement s'il s'agit d'un chemin relatif de ce site (400 sinon : pas de redirection ouverte).
+//   - Sinon rediriger vers la page d'erreur : message du service pour une erreur de saisie, message générique
+//     (et journalisation) pour une erreur interne.
+func finishFavoritesChange(w http.ResponseWriter, r *http.Request, status int, err error, failure string) {
+	if err != nil {
+		if status == http.StatusInternalServerError {
+			log.Printf("%s: %v", failure, err)
+			helpers.RedirectToError(w, r, status, failure)
+			return
+		}
+		helpers.RedirectToError(w, r, status, err.Error())
+		return
+	}
+
+	returnURL, ok := helpers.SafeReturnURL(r.FormValue("returnUrl"))
+	if !ok {
+		helpers.RedirectToError(w, r, http.StatusBadRequest, returnURLErrorMessage)
+		return
+	}
+	http.Redirect(w, r, returnURL, http.StatusSeeOther)
+}
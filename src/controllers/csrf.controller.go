@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"errors"
+	"f1-app/helpers"
+	"f1-app/services"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// csrfCookieName est le cookie qui porte le jeton CSRF du navigateur (comparé au jeton renvoyé par les formulaires).
+const csrfCookieName = "f1_csrf"
+
+// csrfMaxFormSize limite la taille d'un formulaire lu par le middleware CSRF (import de favoris compris).
+const csrfMaxFormSize = services.MaxFavoritesImportSize + 64<<10
+
+// graphQLPath est la route de l'API GraphQL : ses requêtes de lecture en POST sont ouvertes aux clients sans navigateur,
+// ses mutations exigent le jeton dans l'en-tête X-CSRF-Token (vérifié par GraphQLHandler selon l'opération).
+const graphQLPath = "/graphql"
+
+// Messages d'erreur des requêtes refusées par le middleware CSRF.
+const (
+	csrfErrorMessage               = "Requête refusée : jeton de sécurité (CSRF) absent ou invalide. Rechargez la page puis réessayez."
+	returnURLErrorMessage          = "Adresse de retour refusée : seuls les chemins relatifs de ce site sont acceptés (returnUrl)"
+	graphQLContentTypeErrorMessage = "Type de corps non pris en charge (application/json ou application/graphql attendu)"
+	originErrorMessage             = "Requête refusée : origine différente de ce site"
+)
+
+// CSRFMiddleware
+// --------------
+// Objectif :
+//   - Attribuer à chaque navigateur un jeton CSRF (cookie f1_csrf) et le transmettre aux templates via le contexte,
+//     qui l'ajoutent à chaque formulaire POST (champ csrf_token) et à la balise <meta name="csrf-token">.
+//   - Refuser (403) toute requête POST, PUT, PATCH ou DELETE sans le jeton du cookie f1_csrf dans le champ csrf_token
+//     ou l'en-tête X-CSRF-Token, avec ou sans cookie de session (connexion ou inscription forcée depuis un autre site).
+//   - Sur /graphql, ne laisser passer sans jeton qu'un corps application/json ou application/graphql (415 sinon, ce
+//     qu'un formulaire ou une requête « simple » d'un autre site ne peut pas envoyer) venant de ce site (Origin, 403
+//     sinon) : les mutations y exigent en plus l'en-tête X-CSRF-Token (GraphQLHandler).
+//   - Refuser (400) une adresse de retour (returnUrl) qui ne serait pas un chemin relatif de ce site.
+//   - Ignorer les fichiers statiques.
+func CSRFMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/static/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Étape 1 : Retrouver ou attribuer le jeton du navigateur.
+		token := ""
+		if cookie, err := r.Cookie(csrfCookieName); err == nil && helpers.IsValidCSRFToken(cookie.Value) {
+			token = cookie.Value
+		}
+		hasToken := token != ""
+		if !hasToken {
+			token = helpers.NewCSRFToken()
+			setCookie(w, r, csrfCookieName, token, 0)
+		}
+		r = r.WithContext(helpers.WithCSRFToken(r.Context(), token))
+		if isSafeMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Étape 2 : Lire le formulaire (taille limitée) pour y trouver le jeton et l'adresse de retour.
+		if err := parseCSRFForm(w, r); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				rejectRequest(w, r, http.StatusRequestEntityTooLarge, "Requête trop volumineuse (1 Mo maximum)")
+				return
+			}
+			rejectRequest(w, r, http.StatusBadRequest, "Formulaire invalide")
+			return
+		}
+
+		// Étape 3 : Vérifier le jeton (GraphQL : type de corps et origine, jeton des mutations vérifié par le handler).
+		if r.URL.Path == graphQLPath {
+			if !isGraphQLContentType(r.Header.Get("Content-Type")) {
+				rejectRequest(w, r, http.StatusUnsupportedMediaType, graphQLContentTypeErrorMessage)
+				return
+			}
+			if !isSameOrigin(r) {
+				rejectRequest(w, r, http.StatusForbidden, originErrorMessage)
+				return
+			}
+		} else {
+			submitted := r.Header.Get(helpers.CSRFHeaderName)
+			if submitted == "" {
+				submitted = r.PostFormValue(helpers.CSRFFieldName)
+			}
+			if !hasToken || !helpers.SameCSRFToken(token, submitted) {
+				rejectRequest(w, r, http.StatusForbidden, csrfErrorMessage)
+				return
+			}
+		}
+
+		// Étape 4 : Refuser une adresse de retour vers un autre site avant toute modification.
+		if _, ok := helpers.SafeReturnURL(r.FormValue("returnUrl")); !ok {
+			rejectRequest(w, r, http.StatusBadRequest, returnURLErrorMessage)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rotateCSRFToken
+// Remplace le jeton CSRF du navigateur (connexion, inscription et déconnexion).
+func rotateCSRFToken(w http.ResponseWriter, r *http.Request) {
+	setCookie(w, r, csrfCookieName, helpers.NewCSRFToken(), 0)
+}
+
+// hasCSRFHeader
+// Indique si la requête renvoie dans l'en-tête X-CSRF-Token le jeton du cookie f1_csrf (mutations GraphQL).
+func hasCSRFHeader(r *http.Request) bool {
+	cookie, err := r.Cookie(csrfCookieName)
+	if err != nil || !helpers.IsValidCSRFToken(cookie.Value) {
+		return false
+	}
+	return helpers.SameCSRFToken(cookie.Value, r.Header.Get(helpers.CSRFHeaderName))
+}
+
+// isGraphQLContentType
+// Indique si le type de corps est accepté par /graphql en POST (JSON ou requête GraphQL brute).
+func isGraphQLContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || mediaType == "application/graphql")
+}
+
+// isSameOrigin
+// Indique si l'en-tête Origin, quand le navigateur l'envoie, désigne ce site (sous-domaines compris refusés).
+func isSameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true // client sans navigateur
+	}
+	parsed, err := url.Parse(origin)
+	return err == nil && parsed.Host != "" && strings.EqualFold(parsed.Host, r.Host)
+}
+
+// isSafeMethod
+// Indique si une méthode HTTP ne modifie rien (aucune vérification CSRF).
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// parseCSRFForm
+// Lit le corps d'un formulaire (urlencoded ou multipart) en limitant sa taille ; les autres corps sont laissés intacts.
+func parseCSRFForm(w http.ResponseWriter, r *http.Request) error {
+	contentType := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, "multipart/form-data"):
+		r.Body = http.MaxBytesReader(w, r.Body, csrfMaxFormSize)
+		return r.ParseMultipartForm(services.MaxFavoritesImportSize)
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		r.Body = http.MaxBytesReader(w, r.Body, csrfMaxFormSize)
+		return r.ParseForm()
+	}
+	return r.ParseForm()
+}
+
+// rejectRequest
+// Refuse une requête : erreur GraphQL sur /graphql, erreur JSON pour l'API ou un client JSON, page d'erreur sinon.
+func rejectRequest(w http.ResponseWriter, r *http.Request, status int, message string) {
+	switch {
+	case r.URL.Path == graphQLPath:
+		writeGraphQLError(w, status, message)
+	case strings.HasPrefix(r.URL.Path, "/api/") || r.Header.Get("Accept") == "application/json":
+		helpers.WriteJSONError(w, status, message)
+	default:
+		helpers.RedirectToError(w, r, status, message)
+	}
+}
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"f1-app/templates"
+	"net/http"
+)
+
+// APIDocsHandler
+// --------------
+// Objectif :
+//   - Afficher la documentation interactive de l'API (GET /api/docs).
+//   - La page charge /api/docs/openapi.json et l'affiche avec /static/api-docs.js (aucun CDN).
+func APIDocsHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Préparer les données pour le template.
+	data := &models.PageData{
+		Title:       "API Documentation",
+		CurrentPage: "api-docs",
+		Data: map[string]interface{}{
+			"season":  services.ResolveSeason(r.URL.Query().Get("season")),
+			"seasons": services.GetSeasons(),
+		},
+	}
+
+	// Étape 3 : Rendre le template "api-docs".
+	templates.RenderTemplate(w, r, "api-docs", data)
+}
+
+// OpenAPIJSONHandler
+// ------------------
+// Objectif :
+//   - Servir la spécification OpenAPI 3 au format JSON (GET /api/docs/openapi.json).
+//   - Autoriser la lecture depuis une autre origine (éditeurs et générateurs de SDK).
+func OpenAPIJSONHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Construire et retourner la spécification.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	helpers.WriteJSON(w, http.StatusOK, services.GetOpenAPIDocument())
+}
+
+// OpenAPIYAMLHandler
+// ------------------
+// Objectif :
+//   - Servir la spécification OpenAPI 3 au format YAML (GET /api/docs/openapi.yaml).
+//   - Autoriser la lecture depuis une autre origine (éditeurs et générateurs de SDK).
+func OpenAPIYAMLHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Construire et retourner la spécification.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	helpers.WriteYAML(w, http.StatusOK, services.GetOpenAPIDocument())
+}
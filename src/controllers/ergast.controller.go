@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ergastPrefix est le préfixe des routes du miroir compatible Ergast.
+const ergastPrefix = "/ergast/f1/"
+
+// ErgastHandler
+// -------------
+// Objectif :
+//   - Servir nos pilotes et écuries enrichis au format de l'API Ergast (miroir compatible).
+//   - Reconnaître /ergast/f1/{season}/drivers[/{id}] et /ergast/f1/{season}/constructors[/{id}].
+//   - Choisir le format selon l'extension (.json ou .xml) ou le paramètre format (JSON par défaut).
+//   - Appliquer les paramètres limit et offset.
+func ErgastHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.WriteJSONError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Analyser le chemin (saison, ressource, identifiant, format).
+	season, resource, id, format, ok := parseErgastPath(r.URL.Path)
+	if format == "" {
+		format = r.URL.Query().Get("format")
+	}
+	if !ok || (format != "" && format != "json" && format != "xml") {
+		helpers.WriteJSONError(w, http.StatusNotFound, "Requête Ergast non reconnue : "+r.URL.Path)
+		return
+	}
+
+	// Étape 3 : Construire la réponse pour la ressource demandée.
+	limit := r.URL.Query().Get("limit")
+	offset := r.URL.Query().Get("offset")
+	requestURL := ergastRequestURL(r)
+
+	var data *models.MRData
+	var status int
+	var err error
+	switch resource {
+	case "drivers":
+		data, status, err = services.GetErgastDriversService(season, id, limit, offset, requestURL)
+	case "constructors":
+		data, status, err = services.GetErgastConstructorsService(season, id, limit, offset, requestURL)
+	}
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur miroir Ergast:", err)
+		helpers.WriteJSONError(w, status, "Impossible de construire la réponse Ergast")
+		return
+	}
+
+	// Étape 4 : Sérialiser en XML (élément racine MRData) ou en JSON (enveloppe F1Response).
+	if format == "xml" {
+		helpers.WriteXML(w, http.StatusOK, data)
+		return
+	}
+	helpers.WriteJSON(w, http.StatusOK, models.F1Response{MRData: *data})
+}
+
+// parseErgastPath
+// ---------------
+// Objectif :
+//   - Découper /ergast/f1/{season}/{resource}[/{id}][.json|.xml].
+//   - Traduire la saison "current" et retourner le format indiqué par l'extension (vide si absente).
+//   - Retourner ok=false pour une ressource non prise en charge.
+func parseErgastPath(urlPath string) (season, resource, id, format string, ok bool) {
+
+	// Étape 1 : Retirer le préfixe et l'extension éventuelle.
+	rest := strings.TrimPrefix(urlPath, ergastPrefix)
+	if rest == urlPath {
+		return "", "", "", "", false
+	}
+	rest = strings.Trim(rest, "/")
+	switch ext := path.Ext(rest); ext {
+	case ".json", ".xml":
+		format = strings.TrimPrefix(ext, ".")
+		rest = strings.TrimSuffix(rest, ext)
+	}
+
+	// Étape 2 : Découper les segments.
+	parts := strings.Split(rest, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", "", "", "", false
+	}
+	season = services.ErgastSeason(parts[0])
+	if !helpers.IsSeasonSegment(season) {
+		return "", "", "", "", false
+	}
+	resource = parts[1]
+	if resource != "drivers" && resource != "constructors" {
+		return "", "", "", "", false
+	}
+	if len(parts) == 3 {
+		if parts[2] == "" {
+			return "", "", "", "", false
+		}
+		id = parts[2]
+	}
+	return season, resource, id, format, true
+}
+
+// ergastRequestURL
+// Reconstruit l'URL absolue de la requête (sans paramètres), renvoyée dans l'attribut url de MRData.
+func ergastRequestURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, r.Host, r.URL.Path)
+}
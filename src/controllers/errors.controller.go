@@ -4,6 +4,7 @@ import (
 	"f1-app/models"
 	"f1-app/templates"
 	"net/http"
+	"strconv"
 )
 
 // ErrorDisplay
@@ -11,6 +12,7 @@ import (
 // Objectif :
 //   - Extraire le code et le message d'erreur des paramètres de requête.
 //   - Afficher la page d'erreur avec les informations fournies.
+//   - Répondre avec le code HTTP affiché lorsqu'il s'agit d'un code d'erreur valide.
 func ErrorDisplay(w http.ResponseWriter, r *http.Request) {
 	// Étape 1 : Récupérer les paramètres de query (code et message).
 	data := models.Error{
@@ -18,6 +20,12 @@ func ErrorDisplay(w http.ResponseWriter, r *http.Request) {
 		Message: r.FormValue("message"),
 	}
 
-	// Étape 2 : Déléguer le rendu au helper de templates (avec gestion d'erreur).
-	templates.RenderTemplate(w, r, "error", data)
+	// Étape 2 : Déterminer le code HTTP de la réponse (200 si le code est absent ou invalide).
+	status := http.StatusOK
+	if code, err := strconv.Atoi(data.Code); err == nil && code >= 400 && code <= 599 {
+		status = code
+	}
+
+	// Étape 3 : Déléguer le rendu au helper de templates (avec gestion d'erreur).
+	templates.RenderTemplateWithStatus(w, r, "error", data, status)
 }
@@ -1,19 +1,21 @@
 package controllers
 
 import (
+	"errors"
 	"f1-app/helpers"
 	"f1-app/models"
 	"f1-app/services"
 	"f1-app/templates"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // DriversHandler
 // ---------------
 // Objectif :
-//   - Afficher la liste des pilotes F1 avec système de filtrage et pagination.
-//   - Récupérer les paramètres de filtrage (équipe, nationalité, type) et pagination depuis l'URL.
+//   - Afficher la liste des pilotes F1 avec système de filtrage, pagination et classement au championnat.
+//   - Récupérer les paramètres de filtrage (équipe, nationalité, type, éventuellement répétés), d'ordre (sort) et de pagination depuis l'URL.
 //   - En cas de succès : rendre le template "drivers" avec les données.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func DriversHandler(w http.ResponseWriter, r *http.Request) {
@@ -24,25 +26,25 @@ func DriversHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 2 : Récupérer les paramètres depuis l'URL.
-	season := r.URL.Query().Get("season")
-	if season == "" {
-		season = "2025"
+	// Étape 2 : Récupérer les paramètres depuis l'URL (saison par défaut si absente).
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+	filters, err := driverFiltersFromQuery(r.URL.Query())
+	if err != nil {
+		helpers.RedirectToError(w, r, http.StatusBadRequest, err.Error())
+		return
 	}
-	teamFilter := r.URL.Query().Get("team")
-	nationalityFilter := r.URL.Query().Get("nationality")
-	driverTypeFilter := r.URL.Query().Get("driverType")
-	pageParam := r.URL.Query().Get("page")
-	perPageParam := r.URL.Query().Get("perPage")
+	sortParam := r.URL.Query().Get("sort")
+	orderParam := r.URL.Query().Get("order")
+	page := pageRequestFromQuery(r.URL.Query())
 
-	// Étape 3 : Appeler services.GetDriverStandingsService avec les filtres.
-	data, status, err := services.GetDriverStandingsService(season, teamFilter, nationalityFilter, driverTypeFilter, pageParam, perPageParam)
+	// Étape 3 : Appeler services.GetDriverStandingsService avec les filtres et l'ordre d'affichage.
+	data, status, err := services.GetDriverStandingsService(season, filters, sortParam, orderParam, page)
 
 	// Étape 4 : Vérifier si status != http.StatusOK ou err != nil.
 	// Si erreur → helpers.RedirectToError(...) + fmt.Println(err) + return.
 	if status != http.StatusOK || err != nil {
 		fmt.Println("Erreur lors de la récupération des pilotes:", err)
-		helpers.RedirectToError(w, r, status, "Impossible de récupérer les pilotes")
+		helpers.RedirectToError(w, r, status, listErrorMessage(status, season, err, "Impossible de récupérer les pilotes"))
 		return
 	}
 
@@ -50,12 +52,57 @@ func DriversHandler(w http.ResponseWriter, r *http.Request) {
 	templates.RenderTemplate(w, r, "drivers", data)
 }
 
+// driverFiltersFromQuery
+// Lit les filtres des pilotes depuis l'URL (les filtres à valeurs peuvent être répétés : ?team=Ferrari&team=McLaren ;
+// erreur si ageMin ou ageMax n'est pas un entier positif).
+func driverFiltersFromQuery(query url.Values) (models.DriverFilters, error) {
+	filters := models.DriverFilters{
+		Team:        services.NormalizeFilterValues(query["team"]),
+		Nationality: services.NormalizeFilterValues(query["nationality"]),
+		DriverType:  services.NormalizeFilterValues(query["driverType"]),
+		Decade:      services.NormalizeFilterValues(query["decade"]),
+		Rookie:      services.NormalizeFilterValues(query["rookie"]),
+	}
+	var err error
+	if filters.AgeMin, err = services.ParseAgeBound(query.Get("ageMin")); err != nil {
+		return filters, err
+	}
+	filters.AgeMax, err = services.ParseAgeBound(query.Get("ageMax"))
+	return filters, err
+}
+
+// constructorFiltersFromQuery
+// Lit les filtres des écuries depuis l'URL (valeurs répétables : ?engine=Ferrari&engine=Mercedes).
+func constructorFiltersFromQuery(query url.Values) models.ConstructorFilters {
+	return models.ConstructorFilters{
+		Nationality: services.NormalizeFilterValues(query["nationality"]),
+		Engine:      services.NormalizeFilterValues(query["engine"]),
+	}
+}
+
+// pageRequestFromQuery
+// Lit les paramètres de pagination depuis l'URL (page, perPage et cursor, qui remplace page).
+func pageRequestFromQuery(query url.Values) models.PageRequest {
+	return models.PageRequest{Page: query.Get("page"), PerPage: query.Get("perPage"), Cursor: query.Get("cursor")}
+}
+
+// listErrorMessage
+// Message d'erreur d'une liste : celui du service pour un paramètre invalide (filtre, taille de page, curseur : 400),
+// sinon le message de la saison.
+func listErrorMessage(status int, season string, err error, message string) string {
+	if status == http.StatusBadRequest && err != nil {
+		return err.Error()
+	}
+	return helpers.SeasonErrorMessage(status, season, message)
+}
+
 // SearchHandler
 // -------------
 // Objectif :
 //   - Gérer la recherche globale dans les pilotes ET les écuries.
-//   - Récupérer toutes les données puis filtrer selon la query de recherche.
-//   - En cas de succès : rendre le template "search" avec les résultats.
+//   - Récupérer toutes les données puis filtrer selon la query de recherche (syntaxe : voir services.ParseSearchQuery).
+//   - En cas de succès : rendre le template "search" avec les résultats triés par pertinence, parties correspondantes surlignées.
+//   - En cas d'erreur de syntaxe : rendre le template "search" (400) avec le message et la partie fautive de la requête.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func SearchHandler(w http.ResponseWriter, r *http.Request) {
 
@@ -65,46 +112,47 @@ func SearchHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 2 : Récupérer la query de recherche depuis l'URL.
+	// Étape 2 : Récupérer la query de recherche et la saison depuis l'URL.
 	query := r.URL.Query().Get("q")
-
-	// Étape 3 : Récupérer TOUTES les données des pilotes.
-	driversData, statusDrivers, errDrivers := services.GetDriverStandingsService("2025", "", "", "", "", "")
-	if statusDrivers != http.StatusOK || errDrivers != nil {
-		helpers.RedirectToError(w, r, statusDrivers, "Erreur lors de la recherche")
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+
+	// Étape 3 : Récupérer les pilotes et écuries correspondant à la recherche
+	// (une erreur de syntaxe est affichée sur la page de recherche, avec la partie fautive de la requête).
+	results, status, err := services.GetRankedSearch(season, query)
+	var syntaxErr *services.SearchSyntaxError
+	if err != nil && !errors.As(err, &syntaxErr) {
+		helpers.RedirectToError(w, r, status, helpers.SeasonErrorMessage(status, season, "Erreur lors de la recherche"))
 		return
 	}
 
-	// Étape 4 : Récupérer TOUTES les données des écuries.
-	teamsData, statusTeams, errTeams := services.GetConstructorStandingsService("2025")
-	if statusTeams != http.StatusOK || errTeams != nil {
-		helpers.RedirectToError(w, r, statusTeams, "Erreur lors de la recherche")
-		return
+	// Étape 4 : Préparer les données pour le template.
+	pageData := map[string]interface{}{
+		"query":        query,
+		"season":       season,
+		"seasons":      services.GetSeasons(),
+		"drivers":      results.Drivers,
+		"constructors": results.Constructors,
+		"fields":       services.SearchFieldNames(),
+	}
+	if syntaxErr != nil {
+		pageData["syntaxError"] = syntaxErr
 	}
-
-	// Étape 5 : Filtrer les résultats selon la query de recherche.
-	filteredDrivers, filteredTeams := services.SearchService(query, driversData.Data["allDrivers"], teamsData.Data["constructors"])
-
-	// Étape 6 : Préparer les données pour le template.
 	data := &models.PageData{
 		Title:       "Search Results",
 		CurrentPage: "search",
-		Data: map[string]interface{}{
-			"query":        query,
-			"drivers":      filteredDrivers,
-			"constructors": filteredTeams,
-		},
+		Data:        pageData,
 	}
 
-	// Étape 7 : Rendre le template "search" avec les résultats.
-	templates.RenderTemplate(w, r, "search", data)
+	// Étape 5 : Rendre le template "search" avec les résultats (400 si la requête est invalide).
+	templates.RenderTemplateWithStatus(w, r, "search", data, status)
 }
 
 // TeamsHandler
 // ------------
 // Objectif :
-//   - Afficher la liste de toutes les écuries F1 pour une saison donnée.
-//   - Récupérer la saison depuis l'URL (par défaut 2025).
+//   - Afficher la liste des écuries F1 pour une saison donnée, avec leur classement au championnat.
+//   - Récupérer la saison (saison par défaut si absente), les filtres (nationality, engine), l'ordre d'affichage
+//     (sort, order) et la pagination (page, perPage) depuis l'URL.
 //   - En cas de succès : rendre le template "teams" avec les données.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func TeamsHandler(w http.ResponseWriter, r *http.Request) {
@@ -115,20 +163,19 @@ func TeamsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 2 : Récupérer la saison depuis l'URL (par défaut 2025).
-	season := r.URL.Query().Get("season")
-	if season == "" {
-		season = "2025"
-	}
+	// Étape 2 : Récupérer la saison, les filtres, l'ordre d'affichage et la pagination depuis l'URL.
+	query := r.URL.Query()
+	season := services.ResolveSeason(query.Get("season"))
+	filters := constructorFiltersFromQuery(query)
 
 	// Étape 3 : Appeler services.GetConstructorStandingsService.
-	data, status, err := services.GetConstructorStandingsService(season)
+	data, status, err := services.GetConstructorStandingsService(season, filters, query.Get("sort"), query.Get("order"), pageRequestFromQuery(query))
 
 	// Étape 4 : Vérifier le statut et l'erreur.
 	// Si erreur → helpers.RedirectToError(...) + fmt.Println(err) + return.
 	if status != http.StatusOK || err != nil {
 		fmt.Println("Erreur lors de la récupération des écuries:", err)
-		helpers.RedirectToError(w, r, status, "Impossible de récupérer les écuries")
+		helpers.RedirectToError(w, r, status, listErrorMessage(status, season, err, "Impossible de récupérer les écuries"))
 		return
 	}
 
@@ -141,7 +188,8 @@ func TeamsHandler(w http.ResponseWriter, r *http.Request) {
 // Objectif :
 //   - Afficher la page d'accueil avec un aperçu des pilotes et écuries.
 //   - Vérifier que l'URL est exactement "/".
-//   - Récupérer les données des pilotes et écuries pour la saison 2025.
+//   - Récupérer les données des pilotes et écuries pour la saison demandée (saison par défaut si absente).
+//   - Récupérer la prochaine course de la saison pour la carte "Next race".
 //   - En cas de succès : rendre le template "index" avec les données.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func IndexHandler(w http.ResponseWriter, r *http.Request) {
@@ -158,34 +206,45 @@ func IndexHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 3 : Définir la saison actuelle.
-	season := "2025"
+	// Étape 3 : Récupérer la saison depuis l'URL (saison par défaut si absente).
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
 
 	// Étape 4 : Récupérer les données des pilotes.
-	driversData, statusDrivers, errDrivers := services.GetDriverStandingsService(season, "", "", "", "", "")
+	driversData, statusDrivers, errDrivers := services.GetDriverStandingsService(season, models.DriverFilters{}, services.SortRoster, "", models.PageRequest{})
 	if statusDrivers != http.StatusOK || errDrivers != nil {
-		helpers.RedirectToError(w, r, statusDrivers, "Impossible de charger la page d'accueil")
+		helpers.RedirectToError(w, r, statusDrivers, helpers.SeasonErrorMessage(statusDrivers, season, "Impossible de charger la page d'accueil"))
 		return
 	}
 
 	// Étape 5 : Récupérer les données des écuries.
-	teamsData, statusTeams, errTeams := services.GetConstructorStandingsService(season)
+	teamsData, statusTeams, errTeams := services.GetConstructorStandingsService(season, models.ConstructorFilters{}, services.SortRoster, "", models.PageRequest{})
 	if statusTeams != http.StatusOK || errTeams != nil {
-		helpers.RedirectToError(w, r, statusTeams, "Impossible de charger la page d'accueil")
+		helpers.RedirectToError(w, r, statusTeams, helpers.SeasonErrorMessage(statusTeams, season, "Impossible de charger la page d'accueil"))
 		return
 	}
 
-	// Étape 6 : Préparer les données pour le template.
+	// Étape 6 : Récupérer la prochaine course (facultatif : la page reste affichable sans calendrier).
+	var nextRace interface{}
+	racesData, statusRaces, errRaces := services.GetRacesService(season)
+	if statusRaces == http.StatusOK && errRaces == nil {
+		nextRace = racesData.Data["nextRace"]
+	} else {
+		fmt.Println("Calendrier indisponible pour la page d'accueil:", errRaces)
+	}
+
+	// Étape 7 : Préparer les données pour le template.
 	pageData := models.PageData{
 		Title: fmt.Sprintf("Saison %s - Accueil", season),
 		Data: map[string]interface{}{
 			"season":       season,
+			"seasons":      services.GetSeasons(),
 			"drivers":      driversData.Data["drivers"],
 			"constructors": teamsData.Data["constructors"],
+			"nextRace":     nextRace,
 		},
 	}
 
-	// Étape 7 : Rendre le template "index" avec les données.
+	// Étape 8 : Rendre le template "index" avec les données.
 	templates.RenderTemplate(w, r, "index", pageData)
 }
 
@@ -193,7 +252,7 @@ func IndexHandler(w http.ResponseWriter, r *http.Request) {
 // -----------------
 // Objectif :
 //   - Afficher la page de détails d'une écurie spécifique.
-//   - Extraire l'ID de l'écurie depuis l'URL.
+//   - Extraire la saison (optionnelle) et l'ID de l'écurie depuis l'URL (/teams/{id} ou /{season}/teams/{id}).
 //   - Récupérer les informations de l'écurie et de ses pilotes.
 //   - Vérifier si l'écurie est dans les favoris.
 //   - En cas de succès : rendre le template "teams-detail" avec les données.
@@ -206,74 +265,41 @@ func TeamDetailHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 2 : Extraire l'ID de l'écurie depuis l'URL.
-	constructorID := r.URL.Path[len("/teams/"):]
-	if constructorID == "" {
-		helpers.RedirectToError(w, r, http.StatusNotFound, "Écurie non trouvée")
-		return
-	}
-
-	// Étape 3 : Récupérer toutes les écuries.
-	teamsData, status, err := services.GetConstructorStandingsService("2025")
-	if status != http.StatusOK || err != nil {
-		helpers.RedirectToError(w, r, status, "Impossible de récupérer les écuries")
-		return
-	}
-
-	// Étape 4 : Convertir les données et rechercher l'écurie demandée.
-	constructors, ok := teamsData.Data["constructors"].([]models.Constructor)
+	// Étape 2 : Extraire la saison et l'ID de l'écurie depuis l'URL.
+	season, constructorID, ok := helpers.ParseDetailPath(r.URL.Path, "teams")
 	if !ok {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Erreur de données")
-		return
-	}
-
-	var team *models.Constructor
-	for _, c := range constructors {
-		if c.ConstructorID == constructorID {
-			team = &c
-			break
-		}
-	}
-
-	if team == nil {
 		helpers.RedirectToError(w, r, http.StatusNotFound, "Écurie non trouvée")
 		return
 	}
-
-	// Étape 5 : Récupérer tous les pilotes.
-	driversData, statusDrivers, errDrivers := services.GetDriverStandingsService("2025", "", "", "", "", "")
-	if statusDrivers != http.StatusOK || errDrivers != nil {
-		helpers.RedirectToError(w, r, statusDrivers, "Impossible de récupérer les pilotes")
-		return
-	}
-
-	allDrivers, ok := driversData.Data["allDrivers"].([]models.Driver)
-	if !ok {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Erreur de données des pilotes")
-		return
+	if season == "" {
+		season = services.ResolveSeason(r.URL.Query().Get("season"))
 	}
 
-	// Étape 6 : Filtrer les pilotes de cette équipe.
-	var teamDrivers []models.Driver
-	for _, d := range allDrivers {
-		if d.Team == team.Name ||
-			(d.Team == "Haas" && team.Name == "Haas F1 Team") ||
-			(d.Team == "Red Bull" && team.Name == "Red Bull Racing") {
-			teamDrivers = append(teamDrivers, d)
+	// Étape 3 : Récupérer l'écurie et ses pilotes (écurie inconnue ou saison inconnue → 404).
+	team, teamDrivers, status, err := services.GetConstructorService(season, constructorID)
+	if status != http.StatusOK || err != nil {
+		message := helpers.SeasonErrorMessage(status, season, "Impossible de récupérer les écuries")
+		if errors.Is(err, services.ErrConstructorNotFound) {
+			message = "Écurie non trouvée"
 		}
+		helpers.RedirectToError(w, r, status, message)
+		return
 	}
 
-	// Étape 7 : Vérifier si l'écurie est dans les favoris.
-	isFavorite := services.IsConstructorFavorite(constructorID)
+	// Étape 4 : Vérifier si l'écurie est dans les favoris du visiteur.
+	isFavorite := services.IsConstructorFavorite(favoritesOwner(r), constructorID)
 
-	// Étape 8 : Préparer les données pour le template.
+	// Étape 5 : Préparer les données pour le template.
 	data := map[string]interface{}{
-		"Team":       team,
-		"Drivers":    teamDrivers,
-		"isFavorite": isFavorite,
+		"Team":         team,
+		"Drivers":      teamDrivers,
+		"season":       season,
+		"seasons":      services.GetSeasons(),
+		"seasonAction": "/teams/" + constructorID,
+		"isFavorite":   isFavorite,
 	}
 
-	// Étape 9 : Rendre le template "teams-detail" avec les données.
+	// Étape 6 : Rendre le template "teams-detail" avec les données.
 	templates.RenderTemplate(w, r, "teams-detail", data)
 }
 
@@ -281,7 +307,7 @@ func TeamDetailHandler(w http.ResponseWriter, r *http.Request) {
 // -------------------
 // Objectif :
 //   - Afficher la page de détails d'un pilote spécifique.
-//   - Extraire l'ID du pilote depuis l'URL.
+//   - Extraire la saison (optionnelle) et l'ID du pilote depuis l'URL (/drivers/{id} ou /{season}/drivers/{id}).
 //   - Récupérer les informations du pilote et de son équipe.
 //   - Vérifier si le pilote est dans les favoris.
 //   - En cas de succès : rendre le template "drivers-detail" avec les données.
@@ -294,76 +320,40 @@ func DriverDetailHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 2 : Extraire l'ID du pilote depuis l'URL.
-	driverPathPrefix := "/drivers/"
-	if len(r.URL.Path) <= len(driverPathPrefix) {
+	// Étape 2 : Extraire la saison et l'ID du pilote depuis l'URL.
+	season, driverID, ok := helpers.ParseDetailPath(r.URL.Path, "drivers")
+	if !ok {
 		helpers.RedirectToError(w, r, http.StatusBadRequest, "ID du pilote manquant")
 		return
 	}
-	driverID := r.URL.Path[len(driverPathPrefix):]
-
-	// Étape 3 : Récupérer tous les pilotes.
-	driversData, statusDrivers, errDrivers := services.GetDriverStandingsService("2025", "", "", "", "", "")
-	if statusDrivers != http.StatusOK || errDrivers != nil {
-		helpers.RedirectToError(w, r, statusDrivers, "Impossible de récupérer les pilotes")
-		return
-	}
-
-	// Étape 4 : Convertir les données et rechercher le pilote demandé.
-	allDrivers, ok := driversData.Data["allDrivers"].([]models.Driver)
-	if !ok {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Erreur de données des pilotes")
-		return
+	if season == "" {
+		season = services.ResolveSeason(r.URL.Query().Get("season"))
 	}
 
-	var driver *models.Driver
-	for _, d := range allDrivers {
-		if d.DriverID == driverID {
-			driver = &d
-			break
+	// Étape 3 : Récupérer le pilote et son écurie (pilote inconnu ou saison inconnue → 404).
+	driver, team, status, err := services.GetDriverService(season, driverID)
+	if status != http.StatusOK || err != nil {
+		message := helpers.SeasonErrorMessage(status, season, "Impossible de récupérer les pilotes")
+		if errors.Is(err, services.ErrDriverNotFound) {
+			message = "Pilote non trouvé"
 		}
-	}
-
-	if driver == nil {
-		helpers.RedirectToError(w, r, http.StatusNotFound, "Pilote non trouvé")
-		return
-	}
-
-	// Étape 5 : Récupérer toutes les écuries.
-	teamsData, statusTeams, errTeams := services.GetConstructorStandingsService("2025")
-	if statusTeams != http.StatusOK || errTeams != nil {
-		helpers.RedirectToError(w, r, statusTeams, "Impossible de récupérer les écuries")
+		helpers.RedirectToError(w, r, status, message)
 		return
 	}
 
-	constructors, ok := teamsData.Data["constructors"].([]models.Constructor)
-	if !ok {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Erreur de données des écuries")
-		return
-	}
-
-	// Étape 6 : Trouver l'équipe du pilote.
-	var team *models.Constructor
-	for _, c := range constructors {
-		if c.Name == driver.Team ||
-			(driver.Team == "Haas" && c.Name == "Haas F1 Team") ||
-			(driver.Team == "Red Bull" && c.Name == "Red Bull Racing") {
-			team = &c
-			break
-		}
-	}
-
-	// Étape 7 : Vérifier si le pilote est dans les favoris.
-	isFavorite := services.IsDriverFavorite(driverID)
+	// Étape 4 : Vérifier si le pilote est dans les favoris du visiteur.
+	isFavorite := services.IsDriverFavorite(favoritesOwner(r), driverID)
 
-	// Étape 8 : Préparer les données pour le template.
+	// Étape 5 : Préparer les données pour le template.
 	data := map[string]interface{}{
-		"Driver":     driver,
-		"Team":       team,
-		"season":     "2025",
-		"isFavorite": isFavorite,
+		"Driver":       driver,
+		"Team":         team,
+		"season":       season,
+		"seasons":      services.GetSeasons(),
+		"seasonAction": "/drivers/" + driverID,
+		"isFavorite":   isFavorite,
 	}
 
-	// Étape 9 : Rendre le template "drivers-detail" avec les données.
+	// Étape 6 : Rendre le template "drivers-detail" avec les données.
 	templates.RenderTemplate(w, r, "drivers-detail", data)
 }
@@ -5,16 +5,20 @@ import (
 	"f1-app/models"
 	"f1-app/services"
 	"f1-app/templates"
+	"log"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
 // FavoritesHandler
 // ----------------
 // Objectif :
-//   - Afficher la page des favoris avec les pilotes et écuries favoris.
-//   - Charger les favoris depuis le fichier JSON.
-//   - Récupérer toutes les données puis filtrer pour n'afficher que les favoris.
+//   - Afficher la page des favoris du visiteur (compte connecté ou cookie anonyme), rangés en collections.
+//   - Regrouper les éléments par collection, type ou tag (paramètre group) et les trier (paramètre sort).
+//   - Permettre le réordonnancement uniquement dans la vue par collection triée dans l'ordre de l'utilisateur.
+//   - Proposer l'export, l'import et le lien de partage en lecture seule (affiché s'il est actif).
+//   - Proposer d'annuler le dernier ajout ou retrait (historique des favoris).
 //   - En cas de succès : rendre le template "favorites" avec les données.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func FavoritesHandler(w http.ResponseWriter, r *http.Request) {
@@ -25,88 +29,83 @@ func FavoritesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Étape 2 : Charger les favoris depuis le fichier JSON.
-	favorites, err := services.LoadFavorites()
-	if err != nil {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Impossible de charger les favoris")
-		return
-	}
-
-	// Étape 3 : Récupérer tous les pilotes.
-	driversData, statusDrivers, errDrivers := services.GetDriverStandingsService("2025", "", "", "", "", "")
-	if statusDrivers != http.StatusOK || errDrivers != nil {
-		helpers.RedirectToError(w, r, statusDrivers, "Impossible de récupérer les pilotes")
-		return
-	}
-
-	allDrivers, ok := driversData.Data["allDrivers"].([]models.Driver)
+	// Étape 2 : Charger les favoris du visiteur selon la saison, le regroupement et le tri de l'URL.
+	owner := favoritesOwner(r)
+	pageData, ok := loadFavoritesPage(w, r, owner, "/favorites")
 	if !ok {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Erreur de données des pilotes")
 		return
 	}
 
-	// Étape 4 : Récupérer toutes les écuries.
-	teamsData, statusTeams, errTeams := services.GetConstructorStandingsService("2025")
-	if statusTeams != http.StatusOK || errTeams != nil {
-		helpers.RedirectToError(w, r, statusTeams, "Impossible de récupérer les écuries")
-		return
-	}
-
-	allConstructors, ok := teamsData.Data["constructors"].([]models.Constructor)
-	if !ok {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Erreur de données des écuries")
-		return
-	}
-
-	// Étape 5 : Construire une map des pilotes par ID pour des recherches O(1).
-	driverByID := make(map[string]models.Driver, len(allDrivers))
-	for _, driver := range allDrivers {
-		driverByID[driver.DriverID] = driver
+	// Étape 3 : Ajouter le lien de partage actif, le dernier changement annulable et le résultat d'un import.
+	share, err := services.FavoritesShareOf(owner)
+	if err != nil {
+		log.Printf("erreur lecture lien de partage: %v", err)
 	}
-
-	// Étape 6 : Filtrer les pilotes favoris.
-	favoriteDrivers := make([]models.Driver, 0, len(favorites.Drivers))
-	for _, driverID := range favorites.Drivers {
-		if driver, exists := driverByID[driverID]; exists {
-			favoriteDrivers = append(favoriteDrivers, driver)
-		}
+	if share != nil {
+		pageData["shareUrl"] = sharedFavoritesURL(r, share.Token)
+		pageData["sharedSince"] = share.CreatedAt
 	}
-
-	// Étape 7 : Construire une map des écuries par ID pour des recherches O(1).
-	constructorByID := make(map[string]models.Constructor, len(allConstructors))
-	for _, constructor := range allConstructors {
-		constructorByID[constructor.ConstructorID] = constructor
+	if lastChange := services.LastFavoriteChange(owner); lastChange != nil {
+		pageData["lastChange"] = lastChange
 	}
-
-	// Étape 8 : Filtrer les écuries favorites.
-	favoriteConstructors := make([]models.Constructor, 0, len(favorites.Constructors))
-	for _, constructorID := range favorites.Constructors {
-		if constructor, exists := constructorByID[constructorID]; exists {
-			favoriteConstructors = append(favoriteConstructors, constructor)
-		}
+	if imported, err := strconv.Atoi(r.URL.Query().Get("imported")); err == nil && imported >= 0 {
+		pageData["imported"] = imported
 	}
 
-	// Étape 9 : Préparer les données pour le template.
+	// Étape 4 : Rendre le template "favorites" avec les données.
 	data := &models.PageData{
 		Title:       "My Favorites",
 		CurrentPage: "favorites",
-		Data: map[string]interface{}{
-			"drivers":      favoriteDrivers,
-			"constructors": favoriteConstructors,
-			"season":       "2025",
-		},
+		Data:        pageData,
 	}
-
-	// Étape 10 : Rendre le template "favorites" avec les données.
 	templates.RenderTemplate(w, r, "favorites", data)
 }
 
+// loadFavoritesPage
+// -----------------
+// Objectif :
+//   - Charger les favoris d'un propriétaire avec la saison, le regroupement (group) et le tri (sort) de l'URL.
+//   - Préparer les données communes du template "favorites" (pagePath : cible du formulaire de regroupement).
+//   - En cas d'erreur : rediriger vers une page d'erreur et retourner faux.
+func loadFavoritesPage(w http.ResponseWriter, r *http.Request, owner, pagePath string) (map[string]interface{}, bool) {
+
+	// Étape 1 : Récupérer la saison, le regroupement et le tri depuis l'URL.
+	query := r.URL.Query()
+	season := services.ResolveSeason(query.Get("season"))
+	group := services.ResolveFavoritesGroup(query.Get("group"))
+	sortParam := services.ResolveFavoritesSort(query.Get("sort"))
+
+	// Étape 2 : Charger les favoris et retrouver les pilotes et écuries correspondants.
+	favorites, groups, status, err := services.GetFavoritesPageService(owner, season, group, sortParam)
+	if status != http.StatusOK || err != nil {
+		helpers.RedirectToError(w, r, status, helpers.SeasonErrorMessage(status, season, "Impossible de charger les favoris"))
+		return nil, false
+	}
+
+	// Étape 3 : Préparer les données pour le template.
+	return map[string]interface{}{
+		"groups":            groups,
+		"collections":       favorites.Collections,
+		"defaultCollection": services.DefaultFavoritesCollectionID,
+		"isEmpty":           len(favorites.Drivers) == 0 && len(favorites.Constructors) == 0,
+		"group":             group,
+		"sort":              sortParam,
+		"reorderable":       group == services.FavoritesGroupCollection && sortParam == services.FavoritesSortPosition,
+		"pagePath":          pagePath,
+		"orphans":           len(services.OrphanedEntries(groups)),
+		"returnUrl":         r.URL.RequestURI(),
+		"season":            season,
+		"seasons":           services.GetSeasons(),
+		"user":              services.ViewerFromContext(r.Context()).User,
+	}, true
+}
+
 // AddFavoriteHandler
 // ------------------
 // Objectif :
-//   - Ajouter un élément (pilote ou écurie) aux favoris.
-//   - Récupérer les paramètres type, id et returnUrl depuis le formulaire.
-//   - Appeler le service approprié selon le type (driver ou constructor).
+//   - Ajouter un élément (pilote ou écurie) aux favoris du visiteur.
+//   - Récupérer les paramètres type, id, collection (facultatif) et returnUrl depuis le formulaire.
+//   - Ajouter l'élément à la collection demandée, ou à la collection par défaut, et l'enregistrer dans l'historique.
 //   - Rediriger vers la page d'origine après l'ajout.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func AddFavoriteHandler(w http.ResponseWriter, r *http.Request) {
@@ -120,44 +119,26 @@ func AddFavoriteHandler(w http.ResponseWriter, r *http.Request) {
 	// Étape 2 : Récupérer les paramètres du formulaire.
 	itemType := r.FormValue("type")
 	itemID := r.FormValue("id")
-	returnURL := r.FormValue("returnUrl")
 
 	if itemID == "" || itemType == "" {
 		helpers.RedirectToError(w, r, http.StatusBadRequest, "Paramètres manquants")
 		return
 	}
 
-	// Étape 3 : Ajouter aux favoris selon le type (driver ou constructor).
-	var err error
-	switch itemType {
-	case "driver":
-		err = services.AddDriverToFavorites(itemID)
-	case "constructor":
-		err = services.AddConstructorToFavorites(itemID)
-	default:
-		helpers.RedirectToError(w, r, http.StatusBadRequest, "Type invalide")
-		return
-	}
-
-	// Étape 4 : Vérifier s'il y a eu une erreur lors de l'ajout.
-	if err != nil {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Impossible d'ajouter aux favoris")
-		return
-	}
+	// Étape 3 : Ajouter à la collection demandée (collection par défaut si absente).
+	status, err := services.AddFavoriteToCollection(favoritesOwner(r), favoritesActor(r), r.FormValue("collection"), itemType, itemID)
 
-	// Étape 5 : Rediriger vers la page d'origine.
-	if returnURL == "" {
-		returnURL = "/"
-	}
-	http.Redirect(w, r, returnURL, http.StatusSeeOther)
+	// Étape 4 : Rediriger vers la page d'origine, ou vers une page d'erreur.
+	finishFavoritesChange(w, r, status, err, "Impossible d'ajouter aux favoris")
 }
 
 // RemoveFavoriteHandler
 // ---------------------
 // Objectif :
-//   - Supprimer un élément (pilote ou écurie) des favoris.
-//   - Récupérer les paramètres type, id et returnUrl depuis le formulaire.
-//   - Appeler le service approprié selon le type (driver ou constructor).
+//   - Supprimer un élément (pilote ou écurie) des favoris du visiteur.
+//   - Récupérer les paramètres type, id, collection (facultatif) et returnUrl depuis le formulaire.
+//   - Retirer l'élément de la collection demandée, ou de toutes les collections, et l'enregistrer dans l'historique
+//     (annulable depuis la page des favoris).
 //   - Rediriger vers la page d'origine après la suppression.
 //   - En cas d'erreur : rediriger vers une page d'erreur.
 func RemoveFavoriteHandler(w http.ResponseWriter, r *http.Request) {
@@ -171,36 +152,35 @@ func RemoveFavoriteHandler(w http.ResponseWriter, r *http.Request) {
 	// Étape 2 : Récupérer les paramètres du formulaire.
 	itemType := r.FormValue("type")
 	itemID := r.FormValue("id")
-	returnURL := r.FormValue("returnUrl")
 
 	if itemID == "" || itemType == "" {
 		helpers.RedirectToError(w, r, http.StatusBadRequest, "Paramètres manquants")
 		return
 	}
 
-	// Étape 3 : Supprimer des favoris selon le type (driver ou constructor).
-	var err error
-	switch itemType {
-	case "driver":
-		err = services.RemoveDriverFromFavorites(itemID)
-	case "constructor":
-		err = services.RemoveConstructorFromFavorites(itemID)
-	default:
-		helpers.RedirectToError(w, r, http.StatusBadRequest, "Type invalide")
-		return
-	}
+	// Étape 3 : Retirer de la collection demandée (de toutes les collections si absente).
+	status, err := services.RemoveFavoriteFromCollection(favoritesOwner(r), favoritesActor(r), r.FormValue("collection"), itemType, itemID)
 
-	// Étape 4 : Vérifier s'il y a eu une erreur lors de la suppression.
-	if err != nil {
-		helpers.RedirectToError(w, r, http.StatusInternalServerError, "Impossible de supprimer des favoris")
+	// Étape 4 : Rediriger vers la page d'origine, ou vers une page d'erreur.
+	finishFavoritesChange(w, r, status, err, "Impossible de supprimer des favoris")
+}
+
+// RemoveOrphanedFavoritesHandler
+// ------------------------------
+// Objectif :
+//   - Retirer des favoris du visiteur les éléments qui n'existent plus dans aucune saison ("no longer on the grid").
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur.
+func RemoveOrphanedFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
 		return
 	}
 
-	// Étape 5 : Rediriger vers la page d'origine.
-	if returnURL == "" {
-		returnURL = "/"
-	}
-	http.Redirect(w, r, returnURL, http.StatusSeeOther)
+	// Étape 2 : Retirer les éléments orphelins puis rediriger.
+	_, status, err := services.RemoveOrphanedFavorites(favoritesOwner(r))
+	finishFavoritesChange(w, r, status, err, "Impossible de retirer les favoris obsolètes")
 }
 
 // AboutHandler
@@ -229,7 +209,10 @@ func AboutHandler(w http.ResponseWriter, r *http.Request) {
 	data := &models.PageData{
 		Title:       "About",
 		CurrentPage: "about",
-		Data:        map[string]interface{}{},
+		Data: map[string]interface{}{
+			"season":  services.ResolveSeason(r.URL.Query().Get("season")),
+			"seasons": services.GetSeasons(),
+		},
 	}
 
 	// Étape 4 : Rendre le template "about" avec les données.
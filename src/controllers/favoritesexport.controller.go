@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"errors"
+	"f1-app/helpers"
+	"f1-app/services"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ExportFavoritesHandler
+// ----------------------
+// Objectif :
+//   - Télécharger les favoris du visiteur en JSON ou en CSV (paramètre format, json par défaut).
+//   - Proposer un nom de fichier daté (f1-favorites-AAAA-MM-JJ.json).
+//   - En cas d'erreur : rediriger vers une page d'erreur.
+func ExportFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Exporter les favoris au format demandé.
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = services.FavoritesFormatJSON
+	}
+	data, contentType, status, err := services.ExportFavorites(favoritesOwner(r), format)
+	if err != nil {
+		finishFavoritesChange(w, r, status, err, "Impossible d'exporter les favoris")
+		return
+	}
+
+	// Étape 3 : Envoyer le fichier en pièce jointe.
+	fileName := fmt.Sprintf("f1-favorites-%s.%s", time.Now().UTC().Format("2006-01-02"), format)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(data)
+}
+
+// ImportFavoritesHandler
+// ----------------------
+// Objectif :
+//   - Importer un fichier de favoris envoyé en multipart/form-data (champ file, 1 Mo maximum) :
+//     export JSON ou CSV de l'application, ou ancien format {"drivers", "constructors"}.
+//   - Fusionner avec les favoris existants (mode=merge, par défaut) ou les remplacer (mode=replace).
+//   - Rediriger vers la page des favoris avec le nombre d'éléments importés, ou vers une page d'erreur
+//     (fichier invalide ou identifiants inconnus : rien n'est importé).
+func ImportFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Lire le fichier envoyé en limitant la taille de la requête.
+	r.Body = http.MaxBytesReader(w, r.Body, services.MaxFavoritesImportSize+64<<10)
+	if err := r.ParseMultipartForm(services.MaxFavoritesImportSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			helpers.RedirectToError(w, r, http.StatusRequestEntityTooLarge, "Fichier trop volumineux (1 Mo maximum)")
+			return
+		}
+		helpers.RedirectToError(w, r, http.StatusBadRequest, "Formulaire d'import invalide")
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		helpers.RedirectToError(w, r, http.StatusBadRequest, "Fichier manquant")
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, services.MaxFavoritesImportSize+1))
+	if err != nil {
+		helpers.RedirectToError(w, r, http.StatusBadRequest, "Fichier illisible")
+		return
+	}
+	if len(data) > services.MaxFavoritesImportSize {
+		helpers.RedirectToError(w, r, http.StatusRequestEntityTooLarge, "Fichier trop volumineux (1 Mo maximum)")
+		return
+	}
+
+	// Étape 3 : Importer les favoris.
+	count, status, err := services.ImportFavorites(favoritesOwner(r), data, r.FormValue("mode"))
+	if err != nil {
+		finishFavoritesChange(w, r, status, err, "Impossible d'importer les favoris")
+		return
+	}
+
+	// Étape 4 : Rediriger vers la page des favoris avec le résultat.
+	http.Redirect(w, r, fmt.Sprintf("/favorites?imported=%d", count), http.StatusSeeOther)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"f1-app/templates"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// FavoritesHistoryHandler
+// -----------------------
+// Objectif :
+//   - Afficher le journal des ajouts et retraits de favoris du visiteur, du plus récent au plus ancien (page, perPage).
+//   - Indiquer l'auteur et la date de chaque changement, les changements annulés et celui que « Undo » annulerait.
+//   - En cas d'erreur : rediriger vers une page d'erreur.
+func FavoritesHistoryHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Charger la page demandée du journal.
+	query := r.URL.Query()
+	entries, pagination, status, err := services.GetFavoritesHistory(favoritesOwner(r), query.Get("page"), query.Get("perPage"))
+	if err != nil {
+		log.Printf("erreur lecture historique des favoris: %v", err)
+		helpers.RedirectToError(w, r, status, "Impossible de charger l'historique des favoris")
+		return
+	}
+
+	// Étape 3 : Préparer les données pour le template.
+	pageData := map[string]interface{}{
+		"entries":     entries,
+		"currentPage": pagination.Page,
+		"perPage":     pagination.PerPage,
+		"totalPages":  pagination.TotalPages,
+		"total":       pagination.Total,
+		"returnUrl":   r.URL.RequestURI(),
+		"season":      services.ResolveSeason(query.Get("season")),
+		"seasons":     services.GetSeasons(),
+		"user":        services.ViewerFromContext(r.Context()).User,
+	}
+	if rebuilt, err := strconv.Atoi(query.Get("rebuilt")); err == nil && rebuilt >= 0 {
+		pageData["rebuilt"] = rebuilt
+	}
+
+	// Étape 4 : Rendre le template "favorites-history" avec les données.
+	data := &models.PageData{
+		Title:       "Favorites History",
+		CurrentPage: "favorites",
+		Data:        pageData,
+	}
+	templates.RenderTemplate(w, r, "favorites-history", data)
+}
+
+// UndoFavoriteHandler
+// -------------------
+// Objectif :
+//   - Annuler le dernier ajout ou retrait de favori du visiteur (un nouvel appel annule le précédent, etc.).
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur (409 s'il n'y a rien à annuler).
+func UndoFavoriteHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Annuler le dernier changement puis rediriger.
+	_, status, err := services.UndoLastFavoriteChange(favoritesOwner(r), favoritesActor(r))
+	finishFavoritesChange(w, r, status, err, "Impossible d'annuler la dernière modification")
+}
+
+// RebuildFavoritesHandler
+// -----------------------
+// Objectif :
+//   - Reconstruire les favoris du visiteur à partir de son journal (restauration après une perte ou une corruption).
+//   - Rediriger vers l'historique avec le nombre d'événements rejoués, ou vers une page d'erreur (404 sans journal).
+func RebuildFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Rejouer le journal.
+	replayed, status, err := services.RebuildFavorites(favoritesOwner(r))
+	if err != nil {
+		finishFavoritesChange(w, r, status, err, "Impossible de reconstruire les favoris")
+		return
+	}
+
+	// Étape 3 : Rediriger vers l'historique avec le résultat.
+	http.Redirect(w, r, fmt.Sprintf("/favorites/history?rebuilt=%d", replayed), http.StatusSeeOther)
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"f1-app/templates"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ShareFavoritesHandler
+// ---------------------
+// Objectif :
+//   - Créer un lien de partage en lecture seule des favoris du visiteur (/shared/<jeton>).
+//   - Remplacer le lien précédent s'il existe (l'ancien lien cesse de fonctionner).
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur.
+func ShareFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Créer le lien puis rediriger.
+	_, status, err := services.ShareFavorites(favoritesOwner(r))
+	finishFavoritesChange(w, r, status, err, "Impossible de partager les favoris")
+}
+
+// UnshareFavoritesHandler
+// -----------------------
+// Objectif :
+//   - Révoquer le lien de partage des favoris du visiteur.
+//   - Rediriger vers la page d'origine (returnUrl), ou vers une page d'erreur.
+func UnshareFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est POST.
+	if r.Method != http.MethodPost {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Révoquer le lien puis rediriger.
+	status, err := services.StopSharingFavorites(favoritesOwner(r))
+	finishFavoritesChange(w, r, status, err, "Impossible d'arrêter le partage")
+}
+
+// SharedFavoritesHandler
+// ----------------------
+// Objectif :
+//   - Afficher en lecture seule les favoris partagés par le jeton de l'URL (/shared/<jeton>).
+//   - Accepter les mêmes paramètres que la page des favoris (season, group, sort), sans aucune action de modification.
+//   - Ne pas transmettre le jeton aux autres sites (Referrer-Policy) ni laisser indexer la page.
+//   - En cas de jeton inconnu ou révoqué : rediriger vers une page 404.
+func SharedFavoritesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Retrouver le propriétaire des favoris partagés.
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+	token := strings.TrimPrefix(r.URL.Path, "/shared/")
+	owner, status, err := services.FindSharedFavorites(token)
+	if err != nil {
+		if status == http.StatusNotFound {
+			helpers.RedirectToError(w, r, status, "Lien de partage introuvable ou révoqué")
+			return
+		}
+		helpers.RedirectToError(w, r, status, "Impossible de charger les favoris partagés")
+		return
+	}
+
+	// Étape 3 : Charger les favoris partagés en lecture seule.
+	pageData, ok := loadFavoritesPage(w, r, owner, "/shared/"+token)
+	if !ok {
+		return
+	}
+	pageData["readOnly"] = true
+	pageData["reorderable"] = false
+
+	// Étape 4 : Rendre le template "favorites" avec les données.
+	data := &models.PageData{
+		Title:       "Shared Favorites",
+		CurrentPage: "favorites",
+		Data:        pageData,
+	}
+	templates.RenderTemplate(w, r, "favorites", data)
+}
+
+// sharedFavoritesURL
+// Construit l'URL absolue d'un lien de partage pour l'hôte de la requête.
+func sharedFavoritesURL(r *http.Request, token string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/shared/%s", scheme, r.Host, token)
+}
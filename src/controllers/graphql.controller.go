@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"encoding/json"
+	"f1-app/helpers"
+	"f1-app/models"
+	"f1-app/services"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// graphQLMaxBodySize limite la taille du corps d'une requête GraphQL (1 Mo).
+const graphQLMaxBodySize = 1 << 20
+
+// GraphQLHandler
+// --------------
+// Objectif :
+//   - Exécuter une requête GraphQL sur les pilotes, écuries et favoris (/graphql).
+//   - GET : paramètres query, operationName et variables (JSON) ; les mutations y sont refusées.
+//   - POST : corps JSON {"query", "operationName", "variables"} ou corps application/graphql (autres types refusés par
+//     le middleware CSRF) ; les mutations exigent le jeton du cookie f1_csrf dans l'en-tête X-CSRF-Token.
+//   - Répondre au format GraphQL ({"data": ..., "errors": [...]}).
+func GraphQLHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Lire la requête selon la méthode HTTP.
+	var request models.GraphQLRequest
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		request.Query = query.Get("query")
+		request.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				writeGraphQLError(w, http.StatusBadRequest, "Paramètre variables invalide (objet JSON attendu)")
+				return
+			}
+		}
+	case http.MethodPost:
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, graphQLMaxBodySize))
+		if err != nil {
+			writeGraphQLError(w, http.StatusRequestEntityTooLarge, "Corps de requête trop volumineux")
+			return
+		}
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/graphql" {
+			request.Query = string(body)
+		} else if err := json.Unmarshal(body, &request); err != nil {
+			writeGraphQLError(w, http.StatusBadRequest, "Corps de requête invalide (JSON attendu)")
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeGraphQLError(w, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Exécuter la requête (mutations autorisées uniquement en POST avec l'en-tête X-CSRF-Token).
+	var mutationRefusal error
+	switch {
+	case r.Method != http.MethodPost:
+		mutationRefusal = services.ErrGraphQLMutationNotAllowed
+	case !hasCSRFHeader(r):
+		mutationRefusal = services.ErrGraphQLMutationCSRF
+	}
+	response, status := services.ExecuteGraphQL(r.Context(), request, mutationRefusal)
+
+	// Étape 3 : Retourner la réponse JSON.
+	helpers.WriteJSON(w, status, response)
+}
+
+// writeGraphQLError
+// Envoie une erreur au format GraphQL ({"errors": [{"message": ...}]}).
+func writeGraphQLError(w http.ResponseWriter, status int, message string) {
+	helpers.WriteJSON(w, status, models.GraphQLResponse{
+		Errors: []models.GraphQLError{{Message: message}},
+	})
+}
@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"errors"
+	"f1-app/helpers"
+	"f1-app/services"
+	"f1-app/templates"
+	"fmt"
+	"net/http"
+)
+
+// RacesHandler
+// ------------
+// Objectif :
+//   - Afficher le calendrier des Grands Prix d'une saison.
+//   - Récupérer la saison depuis l'URL (saison par défaut si absente).
+//   - En cas de succès : rendre le template "races" avec les données.
+//   - En cas d'erreur : rediriger vers une page d'erreur.
+func RacesHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Récupérer la saison depuis l'URL.
+	season := services.ResolveSeason(r.URL.Query().Get("season"))
+
+	// Étape 3 : Appeler services.GetRacesService.
+	data, status, err := services.GetRacesService(season)
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur lors de la récupération du calendrier:", err)
+		helpers.RedirectToError(w, r, status, helpers.SeasonErrorMessage(status, season, "Impossible de récupérer le calendrier"))
+		return
+	}
+
+	// Étape 4 : Rendre le template "races" avec les données.
+	templates.RenderTemplate(w, r, "races", data)
+}
+
+// RaceDetailHandler
+// -----------------
+// Objectif :
+//   - Afficher le détail d'un Grand Prix (circuit, localisation, horaires des séances, résultats).
+//   - Extraire la saison (optionnelle) et la manche depuis l'URL (/races/{round} ou /{season}/races/{round}).
+//   - En cas de succès : rendre le template "race-detail" avec les données.
+//   - En cas d'erreur : rediriger vers une page d'erreur.
+func RaceDetailHandler(w http.ResponseWriter, r *http.Request) {
+
+	// Étape 1 : Vérifier que la méthode HTTP est GET.
+	if r.Method != http.MethodGet {
+		helpers.RedirectToError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée")
+		return
+	}
+
+	// Étape 2 : Extraire la saison et la manche depuis l'URL.
+	season, round, ok := helpers.ParseDetailPath(r.URL.Path, "races")
+	if !ok {
+		helpers.RedirectToError(w, r, http.StatusNotFound, "Course non trouvée")
+		return
+	}
+	if season == "" {
+		season = services.ResolveSeason(r.URL.Query().Get("season"))
+	}
+
+	// Étape 3 : Récupérer la course demandée (manche inconnue ou saison inconnue → 404).
+	race, status, err := services.GetRaceService(season, round)
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur lors de la récupération de la course:", err)
+		message := helpers.SeasonErrorMessage(status, season, "Impossible de récupérer la course")
+		if errors.Is(err, services.ErrRaceNotFound) {
+			message = "Course non trouvée"
+		}
+		helpers.RedirectToError(w, r, status, message)
+		return
+	}
+
+	// Étape 4 : Récupérer les résultats de la manche (sans bloquer l'affichage du calendrier en cas d'échec).
+	results, status, err := services.GetRaceResultsService(season, round)
+	if status != http.StatusOK || err != nil {
+		fmt.Println("Erreur lors de la récupération des résultats:", err)
+		results = nil
+	}
+
+	// Étape 5 : Préparer les données pour le template.
+	data := map[string]interface{}{
+		"Race":         race,
+		"Results":      results,
+		"season":       season,
+		"seasons":      services.GetSeasons(),
+		"seasonAction": "/races",
+	}
+
+	// Étape 6 : Rendre le template "race-detail" avec les données.
+	templates.RenderTemplate(w, r, "race-detail", data)
+}
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"html"
+	"regexp"
+)
+
+// Noms du champ de formulaire et de l'en-tête qui transmettent le jeton CSRF.
+const (
+	CSRFFieldName  = "csrf_token"
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// csrfTokenKey est la clé du jeton CSRF dans le contexte de la requête.
+type csrfTokenKey struct{}
+
+// csrfTokenPattern reconnaît un jeton produit par NewCSRFToken (32 octets en base64 URL sans remplissage).
+var csrfTokenPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+// postFormPattern reconnaît la balise ouvrante d'un formulaire envoyé en POST.
+var postFormPattern = regexp.MustCompile(`(?i)<form\b[^>]*\bmethod\s*=\s*["']?post\b[^>]*>`)
+
+// headEndPattern reconnaît la fin de l'en-tête d'une page HTML.
+var headEndPattern = regexp.MustCompile(`(?i)</head>`)
+
+// NewCSRFToken
+// Génère un jeton CSRF aléatoire (32 octets).
+func NewCSRFToken() string {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		panic("générateur aléatoire indisponible: " + err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// IsValidCSRFToken
+// Indique si une valeur a le format d'un jeton CSRF (cookie modifié ou tronqué sinon).
+func IsValidCSRFToken(token string) bool {
+	return csrfTokenPattern.MatchString(token)
+}
+
+// SameCSRFToken
+// Compare le jeton envoyé au jeton attendu en temps constant (un jeton vide n'est jamais accepté).
+func SameCSRFToken(expected, submitted string) bool {
+	if expected == "" || submitted == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(submitted)) == 1
+}
+
+// WithCSRFToken
+// Retourne un contexte portant le jeton CSRF du visiteur (lu par le rendu des templates).
+func WithCSRFToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, csrfTokenKey{}, token)
+}
+
+// CSRFTokenFromContext
+// Retourne le jeton CSRF du contexte (vide en dehors du middleware CSRF).
+func CSRFTokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(csrfTokenKey{}).(string)
+	return token
+}
+
+// InjectCSRFToken
+// ---------------
+// Objectif :
+//   - Ajouter un champ caché csrf_token au début de chaque formulaire POST d'une page HTML rendue.
+//   - Exposer le jeton aux scripts de la page (<meta name="csrf-token">) pour les requêtes fetch.
+func InjectCSRFToken(page []byte, token string) []byte {
+	escaped := html.EscapeString(token)
+
+	// Étape 1 : Champ caché après chaque balise <form method="post">.
+	field := []byte(`<input type="hidden" name="` + CSRFFieldName + `" value="` + escaped + `">`)
+	page = postFormPattern.ReplaceAllFunc(page, func(tag []byte) []byte {
+		return append(append([]byte{}, tag...), field...)
+	})
+
+	// Étape 2 : Balise meta avant la fin de l'en-tête.
+	meta := []byte(`<meta name="csrf-token" content="` + escaped + `">` + "\n")
+	loc := headEndPattern.FindIndex(page)
+	if loc == nil {
+		return page
+	}
+	result := make([]byte, 0, len(page)+len(meta))
+	result = append(result, page[:loc[0]]...)
+	result = append(result, meta...)
+	return append(result, page[loc[0]:]...)
+}
@@ -32,3 +32,12 @@ func RedirectToError(w http.ResponseWriter, r *http.Request, code int, message s
 	// Étape 3 : Rediriger vers la page d'erreur.
 	http.Redirect(w, r, pathTarget, http.StatusSeeOther)
 }
+
+// SeasonErrorMessage
+// Retourne un message explicite si la saison demandée n'existe pas (404), sinon le message fourni.
+func SeasonErrorMessage(status int, season, message string) string {
+	if status == http.StatusNotFound {
+		return "Aucune donnée disponible pour la saison " + season
+	}
+	return message
+}
@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic
+// ---------------
+// Objectif :
+//   - Écrire un fichier sans jamais laisser de version tronquée (fichier temporaire puis renommage).
+//   - Forcer l'écriture sur disque (fsync) du contenu avant le renommage, puis du dossier après.
+//   - Conserver les droits demandés (perm) sur le fichier final.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+
+	// Étape 1 : Écrire le contenu dans un fichier temporaire du même dossier (renommage atomique).
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("erreur création fichier temporaire: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // sans effet une fois le fichier renommé
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("erreur écriture fichier temporaire: %w", err)
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return fmt.Errorf("erreur droits fichier temporaire: %w", err)
+	}
+
+	// Étape 2 : Forcer l'écriture sur disque avant de remplacer le fichier.
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("erreur synchronisation fichier temporaire: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("erreur fermeture fichier temporaire: %w", err)
+	}
+
+	// Étape 3 : Remplacer le fichier puis synchroniser le dossier (le renommage survit à un crash).
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("erreur remplacement fichier: %w", err)
+	}
+	syncDir(dir)
+	return nil
+}
+
+// syncDir
+// Synchronise un dossier sur disque (sans effet sur les systèmes qui ne le permettent pas).
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	_ = d.Sync()
+}
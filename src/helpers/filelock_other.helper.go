@@ -0,0 +1,9 @@
+//go:build !unix
+
+package helpers
+
+// LockFile
+// Sans flock sur ce système : aucun verrou entre processus (le verrou interne du stockage reste actif).
+func LockFile(path string) (func(), error) {
+	return func() {}, nil
+}
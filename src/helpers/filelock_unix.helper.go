@@ -0,0 +1,36 @@
+//go:build unix
+
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// LockFile
+// --------
+// Objectif :
+//   - Poser un verrou consultatif exclusif (flock) sur le fichier de verrou donné, créé si besoin.
+//   - Bloquer tant qu'un autre processus détient le verrou.
+//   - Retourner la fonction qui libère le verrou.
+func LockFile(path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("erreur ouverture fichier de verrou: %w", err)
+	}
+	for {
+		err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			break
+		}
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("erreur verrouillage fichier: %w", err)
+	}
+	return func() {
+		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
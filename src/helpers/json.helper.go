@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"encoding/json"
+	"f1-app/models"
+	"log"
+	"net/http"
+)
+
+// WriteJSON
+// ---------
+// Objectif :
+//   - Encoder la réponse en JSON avec le code HTTP fourni.
+//   - Positionner l'en-tête Content-Type approprié.
+func WriteJSON(w http.ResponseWriter, status int, payload interface{}) {
+
+	// Étape 1 : Encoder la réponse avant d'écrire l'en-tête (une erreur donne une 500 propre).
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("erreur encodage JSON: %v", err)
+		status = http.StatusInternalServerError
+		body = []byte(`{"error":{"status":500,"message":"Erreur d'encodage de la réponse"}}`)
+	}
+
+	// Étape 2 : Envoyer l'en-tête puis le corps de la réponse.
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(append(body, '\n'))
+}
+
+// WriteJSONError
+// Envoie une erreur au format {"error": {"status": ..., "message": ...}} au lieu d'une redirection vers /error.
+func WriteJSONError(w http.ResponseWriter, status int, message string) {
+	WriteJSON(w, status, models.APIErrorResponse{
+		Error: models.APIError{Status: status, Message: message},
+	})
+}
@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// WritePaginationHeaders
+// ----------------------
+// Objectif :
+//   - Positionner l'en-tête Link (RFC 8288) d'une liste paginée : first, prev, next et last.
+//   - prev et next utilisent les curseurs de la page, first et last les numéros de page.
+//   - Conserver les autres paramètres de la requête (season, filtres, sort, order, perPage).
+//   - Positionner X-Total-Count (nombre d'éléments après filtrage).
+func WritePaginationHeaders(w http.ResponseWriter, r *http.Request, pagination models.Pagination) {
+
+	// Étape 1 : Construire le lien d'une page à partir de la requête courante.
+	link := func(param, value string) string {
+		query := r.URL.Query()
+		query.Del("page")
+		query.Del("cursor")
+		query.Set(param, value)
+		return r.URL.Path + "?" + query.Encode()
+	}
+
+	// Étape 2 : Lister les liens disponibles (aucun pour une liste vide).
+	links := []string{}
+	if pagination.TotalPages > 0 {
+		links = append(links, fmt.Sprintf("<%s>; rel=\"first\"", link("page", "1")))
+	}
+	if pagination.PrevCursor != "" {
+		links = append(links, fmt.Sprintf("<%s>; rel=\"prev\"", link("cursor", pagination.PrevCursor)))
+	}
+	if pagination.NextCursor != "" {
+		links = append(links, fmt.Sprintf("<%s>; rel=\"next\"", link("cursor", pagination.NextCursor)))
+	}
+	if pagination.TotalPages > 0 {
+		links = append(links, fmt.Sprintf("<%s>; rel=\"last\"", link("page", strconv.Itoa(pagination.TotalPages))))
+	}
+
+	// Étape 3 : Positionner les en-têtes (avant l'écriture du corps).
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(pagination.Total))
+}
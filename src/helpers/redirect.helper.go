@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// SafeReturnURL
+// -------------
+// Objectif :
+//   - Valider une adresse de retour (returnUrl) avant de rediriger le visiteur (pas de redirection ouverte).
+//   - N'accepter qu'un chemin relatif de ce site : commence par "/", sans schéma, hôte ("//hôte"),
+//     barre oblique inverse ni caractère de contrôle.
+//   - Retourner "/" pour une adresse vide, et false pour une adresse refusée.
+func SafeReturnURL(raw string) (string, bool) {
+
+	// Étape 1 : Adresse absente : retour à l'accueil.
+	if raw == "" {
+		return "/", true
+	}
+
+	// Étape 2 : Refuser tout ce qui n'est pas un chemin absolu de ce site
+	// (les navigateurs lisent "/\hôte" comme "//hôte" et ignorent tabulations et retours à la ligne).
+	if !strings.HasPrefix(raw, "/") || strings.HasPrefix(raw, "//") || strings.ContainsRune(raw, '\\') {
+		return "", false
+	}
+	if strings.IndexFunc(raw, unicode.IsControl) >= 0 {
+		return "", false
+	}
+
+	// Étape 3 : Vérifier que l'adresse analysée ne désigne aucun autre site.
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme != "" || parsed.Host != "" || parsed.User != nil || !strings.HasPrefix(parsed.Path, "/") {
+		return "", false
+	}
+	return raw, true
+}
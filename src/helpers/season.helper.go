@@ -0,0 +1,42 @@
+package helpers
+
+import "strings"
+
+// IsSeasonSegment
+// Indique si un segment d'URL a la forme d'une saison (année sur 4 chiffres).
+func IsSeasonSegment(segment string) bool {
+	if len(segment) != 4 {
+		return false
+	}
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDetailPath
+// ---------------
+// Objectif :
+//   - Extraire la saison et l'identifiant d'une URL de détail pour une section (drivers, teams).
+//   - Accepter la forme courte /{section}/{id} (saison vide, à résoudre par l'appelant).
+//   - Accepter la forme préfixée /{season}/{section}/{id}.
+//   - Retourner ok=false si l'URL ne correspond à aucune des deux formes.
+func ParseDetailPath(path, section string) (season string, id string, ok bool) {
+
+	// Étape 1 : Découper le chemin en segments.
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	// Étape 2 : Reconnaître la forme courte /{section}/{id}.
+	if len(parts) == 2 && parts[0] == section && parts[1] != "" {
+		return "", parts[1], true
+	}
+
+	// Étape 3 : Reconnaître la forme préfixée /{season}/{section}/{id}.
+	if len(parts) == 3 && IsSeasonSegment(parts[0]) && parts[1] == section && parts[2] != "" {
+		return parts[0], parts[2], true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,29 @@
+package helpers
+
+import (
+	"encoding/xml"
+	"log"
+	"net/http"
+)
+
+// WriteXML
+// --------
+// Objectif :
+//   - Encoder la réponse en XML (avec la déclaration <?xml ...?>) et le code HTTP fourni.
+//   - Positionner l'en-tête Content-Type approprié.
+func WriteXML(w http.ResponseWriter, status int, payload interface{}) {
+
+	// Étape 1 : Encoder la réponse avant d'écrire l'en-tête (une erreur donne une 500 propre).
+	body, err := xml.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		log.Printf("erreur encodage XML: %v", err)
+		WriteJSONError(w, http.StatusInternalServerError, "Erreur d'encodage de la réponse")
+		return
+	}
+
+	// Étape 2 : Envoyer l'en-tête puis la déclaration XML et le corps.
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(append(body, '\n'))
+}
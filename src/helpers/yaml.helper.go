@@ -0,0 +1,160 @@
+package helpers
+
+import (
+	"bytes"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// yamlPlain reconnaît les chaînes pouvant être écrites sans guillemets en YAML.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_ ./(),-]*$`)
+
+// yamlReserved liste les mots interprétés autrement qu'en chaîne par les parseurs YAML (booléens, null).
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "on": true, "off": true, "null": true, "y": true, "n": true,
+}
+
+// WriteYAML
+// ---------
+// Objectif :
+//   - Encoder la réponse en YAML avec le code HTTP fourni.
+//   - Positionner l'en-tête Content-Type approprié.
+func WriteYAML(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(MarshalYAML(payload))
+}
+
+// MarshalYAML
+// -----------
+// Objectif :
+//   - Encoder une valeur en YAML (style bloc) sans dépendance externe.
+//   - Gérer les types produits par la spécification OpenAPI : *models.OrderedMap, map[string]interface{},
+//     []interface{}, []string, chaînes, booléens et nombres.
+//   - Écrire les clés dans leur ordre d'insertion (ordre alphabétique pour une map Go).
+func MarshalYAML(value interface{}) []byte {
+	var buf bytes.Buffer
+	if scalar, ok := yamlInline(value); ok {
+		buf.WriteString(scalar + "\n")
+		return buf.Bytes()
+	}
+	writeYAMLBlock(&buf, value, 0)
+	return buf.Bytes()
+}
+
+// writeYAMLBlock
+// Écrit un objet ou une liste non vide à l'indentation donnée.
+func writeYAMLBlock(buf *bytes.Buffer, value interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+
+	// Étape 1 : Objet (clé: valeur), les valeurs composées passent à la ligne suivante.
+	if keys, get, ok := yamlEntries(value); ok {
+		for _, key := range keys {
+			child := get(key)
+			if scalar, ok := yamlInline(child); ok {
+				buf.WriteString(pad + yamlScalar(key) + ": " + scalar + "\n")
+				continue
+			}
+			buf.WriteString(pad + yamlScalar(key) + ":\n")
+			writeYAMLBlock(buf, child, indent+2)
+		}
+		return
+	}
+
+	// Étape 2 : Liste, un élément composé commence sur la ligne du tiret.
+	for _, item := range yamlItems(value) {
+		if scalar, ok := yamlInline(item); ok {
+			buf.WriteString(pad + "- " + scalar + "\n")
+			continue
+		}
+		var nested bytes.Buffer
+		writeYAMLBlock(&nested, item, indent+2)
+		buf.WriteString(pad + "- ")
+		buf.Write(nested.Bytes()[indent+2:])
+	}
+}
+
+// yamlInline
+// Retourne la représentation sur une ligne d'un scalaire ou d'un conteneur vide ({} / []).
+func yamlInline(value interface{}) (string, bool) {
+	if keys, _, ok := yamlEntries(value); ok {
+		if len(keys) == 0 {
+			return "{}", true
+		}
+		return "", false
+	}
+	if items := yamlItems(value); items != nil {
+		if len(items) == 0 {
+			return "[]", true
+		}
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "null", true
+	case string:
+		return yamlScalar(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int:
+		return strconv.Itoa(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return yamlScalar(fmt.Sprint(v)), true
+	}
+}
+
+// yamlEntries
+// Retourne les clés ordonnées et l'accès aux valeurs si la valeur est un objet.
+func yamlEntries(value interface{}) ([]string, func(string) interface{}, bool) {
+	switch v := value.(type) {
+	case *models.OrderedMap:
+		return v.Keys(), func(key string) interface{} {
+			child, _ := v.Get(key)
+			return child
+		}, true
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys, func(key string) interface{} { return v[key] }, true
+	}
+	return nil, nil, false
+}
+
+// yamlItems
+// Retourne les éléments si la valeur est une liste (nil sinon).
+func yamlItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		if v == nil {
+			return []interface{}{}
+		}
+		return v
+	case []string:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, item)
+		}
+		return items
+	}
+	return nil
+}
+
+// yamlScalar
+// Écrit une chaîne telle quelle si elle est sans ambiguïté, entre guillemets doubles sinon.
+func yamlScalar(s string) string {
+	if yamlPlain.MatchString(s) && !yamlReserved[strings.ToLower(s)] && strings.TrimSpace(s) == s {
+		return s
+	}
+	return strconv.Quote(s)
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// User
+// Structure représentant un compte utilisateur (mot de passe stocké uniquement sous forme de hash bcrypt).
+type User struct {
+	ID           string    `json:"id"`
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"passwordHash"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// UserSession
+// Structure représentant une session de connexion ouverte (seul le hash SHA-256 du jeton du cookie est conservé).
+type UserSession struct {
+	TokenHash string    `json:"tokenHash"`
+	UserID    string    `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// AccountsFile
+// Contenu du fichier users.json (stockage JSON des comptes et des sessions).
+type AccountsFile struct {
+	Users    []User        `json:"users"`
+	Sessions []UserSession `json:"sessions"`
+}
+
+// Viewer
+// Identité du visiteur d'une requête : compte connecté et/ou identifiant anonyme (cookie).
+type Viewer struct {
+	User        *User
+	AnonymousID string
+}
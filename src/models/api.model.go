@@ -0,0 +1,162 @@
+package models
+
+// PageRequest
+// Paramètres de pagination d'une requête : numéro de page, taille de page et curseur opaque
+// (le curseur, s'il est présent, remplace le numéro de page).
+type PageRequest struct {
+	Page    string
+	PerPage string
+	Cursor  string
+}
+
+// Pagination
+// Structure décrivant la page courante d'une liste paginée (partagée par les pages HTML et l'API JSON).
+// Total compte les éléments après filtrage ; NextCursor et PrevCursor sont vides en début ou en fin de liste.
+type Pagination struct {
+	Page       int    `json:"page"`
+	PerPage    int    `json:"perPage"`
+	TotalPages int    `json:"totalPages"`
+	Total      int    `json:"total"`
+	StartIndex int    `json:"startIndex"`
+	EndIndex   int    `json:"endIndex"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+}
+
+// APIError
+// Structure décrivant une erreur renvoyée par l'API JSON.
+type APIError struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// APIErrorResponse
+// Enveloppe JSON d'une réponse d'erreur de l'API ({"error": {...}}).
+type APIErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+// DriverFilters
+// Structure regroupant les filtres applicables à la liste des pilotes
+// (plusieurs valeurs d'un même filtre : l'une d'elles suffit ; filtres différents : tous doivent correspondre).
+// AgeMin et AgeMax bornent l'âge (0 : pas de borne) ; Decade ("1990s") et Rookie ("true", "false") sont déduits de la date de naissance
+// et de la saison précédente.
+type DriverFilters struct {
+	Team        []string `json:"team,omitempty"`
+	Nationality []string `json:"nationality,omitempty"`
+	DriverType  []string `json:"driverType,omitempty"`
+	AgeMin      int      `json:"ageMin,omitempty"`
+	AgeMax      int      `json:"ageMax,omitempty"`
+	Decade      []string `json:"decade,omitempty"`
+	Rookie      []string `json:"rookie,omitempty"`
+}
+
+// ConstructorFilters
+// Structure regroupant les filtres applicables à la liste des écuries (mêmes règles que DriverFilters).
+type ConstructorFilters struct {
+	Nationality []string `json:"nationality,omitempty"`
+	Engine      []string `json:"engine,omitempty"`
+}
+
+// SortOption
+// Ordre proposé sur une liste (valeur du paramètre sort et libellé affiché).
+type SortOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// FacetValue
+// Valeur d'une facette : libellé (s'il diffère de la valeur), nombre de résultats en la sélectionnant
+// (compte tenu des autres filtres) et sélection courante.
+type FacetValue struct {
+	Value    string `json:"value"`
+	Label    string `json:"label,omitempty"`
+	Count    int    `json:"count"`
+	Selected bool   `json:"selected"`
+}
+
+// Facet
+// Facette d'une liste (nom du paramètre d'URL, libellé affiché et valeurs triées par ordre alphabétique).
+type Facet struct {
+	Name   string       `json:"name"`
+	Label  string       `json:"label"`
+	Values []FacetValue `json:"values"`
+}
+
+// DriversResponse
+// Réponse JSON de /api/v1/drivers.
+type DriversResponse struct {
+	Season     string        `json:"season"`
+	Sort       string        `json:"sort"`
+	Order      string        `json:"order"`
+	Filters    DriverFilters `json:"filters"`
+	Facets     []Facet       `json:"facets"`
+	Drivers    []Driver      `json:"drivers"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// DriverResponse
+// Réponse JSON de /api/v1/drivers/{id}.
+type DriverResponse struct {
+	Season      string       `json:"season"`
+	Driver      Driver       `json:"driver"`
+	Constructor *Constructor `json:"constructor,omitempty"`
+}
+
+// ConstructorsResponse
+// Réponse JSON de /api/v1/constructors.
+type ConstructorsResponse struct {
+	Season       string             `json:"season"`
+	Sort         string             `json:"sort"`
+	Order        string             `json:"order"`
+	Filters      ConstructorFilters `json:"filters"`
+	Facets       []Facet            `json:"facets"`
+	Constructors []Constructor      `json:"constructors"`
+	Pagination   Pagination         `json:"pagination"`
+}
+
+// ConstructorResponse
+// Réponse JSON de /api/v1/constructors/{id}.
+type ConstructorResponse struct {
+	Season      string      `json:"season"`
+	Constructor Constructor `json:"constructor"`
+	Drivers     []Driver    `json:"drivers"`
+}
+
+// SearchResponse
+// Réponse JSON de /api/v1/search.
+type SearchResponse struct {
+	Season       string        `json:"season"`
+	Query        string        `json:"query"`
+	Drivers      []Driver      `json:"drivers"`
+	Constructors []Constructor `json:"constructors"`
+}
+
+// Suggestion
+// Élément proposé par l'autocomplétion (Kind : driver ou constructor ; Detail : écurie ou nationalité ; URL : page de détail).
+type Suggestion struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Label  string `json:"label"`
+	Detail string `json:"detail,omitempty"`
+	URL    string `json:"url"`
+}
+
+// SuggestResponse
+// Réponse JSON de /api/search/suggest.
+type SuggestResponse struct {
+	Season      string       `json:"season"`
+	Query       string       `json:"query"`
+	Suggestions []Suggestion `json:"suggestions"`
+}
+
+// FavoritesResponse
+// Réponse JSON de /api/v1/favorites : identifiants enregistrés, fiches correspondantes de la saison
+// et éléments qui n'existent plus dans aucune saison (orphelins).
+type FavoritesResponse struct {
+	Season       string         `json:"season"`
+	Favorites    Favorites      `json:"favorites"`
+	Drivers      []Driver       `json:"drivers"`
+	Constructors []Constructor  `json:"constructors"`
+	Orphans      []FavoriteItem `json:"orphans"`
+}
@@ -0,0 +1,685 @@
+package models
+
+// circuits contains the embedded circuits, keyed by Ergast circuit ID
+var circuits = map[string]Circuit{
+	"albert_park": {
+		CircuitID:   "albert_park",
+		URL:         "https://en.wikipedia.org/wiki/Albert_Park_Circuit",
+		CircuitName: "Albert Park Grand Prix Circuit",
+		Location:    Location{Lat: "-37.8497", Long: "144.968", Locality: "Melbourne", Country: "Australia"},
+	},
+	"shanghai": {
+		CircuitID:   "shanghai",
+		URL:         "https://en.wikipedia.org/wiki/Shanghai_International_Circuit",
+		CircuitName: "Shanghai International Circuit",
+		Location:    Location{Lat: "31.3389", Long: "121.22", Locality: "Shanghai", Country: "China"},
+	},
+	"suzuka": {
+		CircuitID:   "suzuka",
+		URL:         "https://en.wikipedia.org/wiki/Suzuka_International_Racing_Course",
+		CircuitName: "Suzuka Circuit",
+		Location:    Location{Lat: "34.8431", Long: "136.541", Locality: "Suzuka", Country: "Japan"},
+	},
+	"bahrain": {
+		CircuitID:   "bahrain",
+		URL:         "https://en.wikipedia.org/wiki/Bahrain_International_Circuit",
+		CircuitName: "Bahrain International Circuit",
+		Location:    Location{Lat: "26.0325", Long: "50.5106", Locality: "Sakhir", Country: "Bahrain"},
+	},
+	"jeddah": {
+		CircuitID:   "jeddah",
+		URL:         "https://en.wikipedia.org/wiki/Jeddah_Corniche_Circuit",
+		CircuitName: "Jeddah Corniche Circuit",
+		Location:    Location{Lat: "21.6319", Long: "39.1044", Locality: "Jeddah", Country: "Saudi Arabia"},
+	},
+	"miami": {
+		CircuitID:   "miami",
+		URL:         "https://en.wikipedia.org/wiki/Miami_International_Autodrome",
+		CircuitName: "Miami International Autodrome",
+		Location:    Location{Lat: "25.9581", Long: "-80.2389", Locality: "Miami", Country: "USA"},
+	},
+	"imola": {
+		CircuitID:   "imola",
+		URL:         "https://en.wikipedia.org/wiki/Imola_Circuit",
+		CircuitName: "Autodromo Enzo e Dino Ferrari",
+		Location:    Location{Lat: "44.3439", Long: "11.7167", Locality: "Imola", Country: "Italy"},
+	},
+	"monaco": {
+		CircuitID:   "monaco",
+		URL:         "https://en.wikipedia.org/wiki/Circuit_de_Monaco",
+		CircuitName: "Circuit de Monaco",
+		Location:    Location{Lat: "43.7347", Long: "7.42056", Locality: "Monte-Carlo", Country: "Monaco"},
+	},
+	"catalunya": {
+		CircuitID:   "catalunya",
+		URL:         "https://en.wikipedia.org/wiki/Circuit_de_Barcelona-Catalunya",
+		CircuitName: "Circuit de Barcelona-Catalunya",
+		Location:    Location{Lat: "41.57", Long: "2.26111", Locality: "Montmeló", Country: "Spain"},
+	},
+	"villeneuve": {
+		CircuitID:   "villeneuve",
+		URL:         "https://en.wikipedia.org/wiki/Circuit_Gilles_Villeneuve",
+		CircuitName: "Circuit Gilles Villeneuve",
+		Location:    Location{Lat: "45.5", Long: "-73.5228", Locality: "Montreal", Country: "Canada"},
+	},
+	"red_bull_ring": {
+		CircuitID:   "red_bull_ring",
+		URL:         "https://en.wikipedia.org/wiki/Red_Bull_Ring",
+		CircuitName: "Red Bull Ring",
+		Location:    Location{Lat: "47.2197", Long: "14.7647", Locality: "Spielberg", Country: "Austria"},
+	},
+	"silverstone": {
+		CircuitID:   "silverstone",
+		URL:         "https://en.wikipedia.org/wiki/Silverstone_Circuit",
+		CircuitName: "Silverstone Circuit",
+		Location:    Location{Lat: "52.0786", Long: "-1.01694", Locality: "Silverstone", Country: "UK"},
+	},
+	"spa": {
+		CircuitID:   "spa",
+		URL:         "https://en.wikipedia.org/wiki/Circuit_de_Spa-Francorchamps",
+		CircuitName: "Circuit de Spa-Francorchamps",
+		Location:    Location{Lat: "50.4372", Long: "5.97139", Locality: "Spa", Country: "Belgium"},
+	},
+	"hungaroring": {
+		CircuitID:   "hungaroring",
+		URL:         "https://en.wikipedia.org/wiki/Hungaroring",
+		CircuitName: "Hungaroring",
+		Location:    Location{Lat: "47.5789", Long: "19.2486", Locality: "Budapest", Country: "Hungary"},
+	},
+	"zandvoort": {
+		CircuitID:   "zandvoort",
+		URL:         "https://en.wikipedia.org/wiki/Circuit_Zandvoort",
+		CircuitName: "Circuit Park Zandvoort",
+		Location:    Location{Lat: "52.3888", Long: "4.54092", Locality: "Zandvoort", Country: "Netherlands"},
+	},
+	"monza": {
+		CircuitID:   "monza",
+		URL:         "https://en.wikipedia.org/wiki/Monza_Circuit",
+		CircuitName: "Autodromo Nazionale di Monza",
+		Location:    Location{Lat: "45.6156", Long: "9.28111", Locality: "Monza", Country: "Italy"},
+	},
+	"baku": {
+		CircuitID:   "baku",
+		URL:         "https://en.wikipedia.org/wiki/Baku_City_Circuit",
+		CircuitName: "Baku City Circuit",
+		Location:    Location{Lat: "40.3725", Long: "49.8533", Locality: "Baku", Country: "Azerbaijan"},
+	},
+	"marina_bay": {
+		CircuitID:   "marina_bay",
+		URL:         "https://en.wikipedia.org/wiki/Marina_Bay_Street_Circuit",
+		CircuitName: "Marina Bay Street Circuit",
+		Location:    Location{Lat: "1.2914", Long: "103.864", Locality: "Marina Bay", Country: "Singapore"},
+	},
+	"americas": {
+		CircuitID:   "americas",
+		URL:         "https://en.wikipedia.org/wiki/Circuit_of_the_Americas",
+		CircuitName: "Circuit of the Americas",
+		Location:    Location{Lat: "30.1328", Long: "-97.6411", Locality: "Austin", Country: "USA"},
+	},
+	"rodriguez": {
+		CircuitID:   "rodriguez",
+		URL:         "https://en.wikipedia.org/wiki/Autódromo_Hermanos_Rodríguez",
+		CircuitName: "Autódromo Hermanos Rodríguez",
+		Location:    Location{Lat: "19.4042", Long: "-99.0907", Locality: "Mexico City", Country: "Mexico"},
+	},
+	"interlagos": {
+		CircuitID:   "interlagos",
+		URL:         "https://en.wikipedia.org/wiki/Interlagos_Circuit",
+		CircuitName: "Autódromo José Carlos Pace",
+		Location:    Location{Lat: "-23.7036", Long: "-46.6997", Locality: "São Paulo", Country: "Brazil"},
+	},
+	"vegas": {
+		CircuitID:   "vegas",
+		URL:         "https://en.wikipedia.org/wiki/Las_Vegas_Grand_Prix#Circuit",
+		CircuitName: "Las Vegas Strip Street Circuit",
+		Location:    Location{Lat: "36.1147", Long: "-115.173", Locality: "Las Vegas", Country: "USA"},
+	},
+	"losail": {
+		CircuitID:   "losail",
+		URL:         "https://en.wikipedia.org/wiki/Lusail_International_Circuit",
+		CircuitName: "Losail International Circuit",
+		Location:    Location{Lat: "25.49", Long: "51.4542", Locality: "Lusail", Country: "Qatar"},
+	},
+	"yas_marina": {
+		CircuitID:   "yas_marina",
+		URL:         "https://en.wikipedia.org/wiki/Yas_Marina_Circuit",
+		CircuitName: "Yas Marina Circuit",
+		Location:    Location{Lat: "24.4672", Long: "54.6031", Locality: "Abu Dhabi", Country: "UAE"},
+	},
+}
+
+// Races2025Data contains the 2025 calendar with the UTC schedule of every session
+var Races2025Data = []Race{
+	{
+		Season:         "2025",
+		Round:          "1",
+		URL:            "https://en.wikipedia.org/wiki/2025_Australian_Grand_Prix",
+		RaceName:       "Australian Grand Prix",
+		Circuit:        circuits["albert_park"],
+		Date:           "2025-03-16",
+		Time:           "04:00:00Z",
+		FirstPractice:  &Session{Date: "2025-03-14", Time: "01:30:00Z"},
+		SecondPractice: &Session{Date: "2025-03-14", Time: "05:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-03-15", Time: "01:30:00Z"},
+		Qualifying:     &Session{Date: "2025-03-15", Time: "05:00:00Z"},
+	},
+	{
+		Season:           "2025",
+		Round:            "2",
+		URL:              "https://en.wikipedia.org/wiki/2025_Chinese_Grand_Prix",
+		RaceName:         "Chinese Grand Prix",
+		Circuit:          circuits["shanghai"],
+		Date:             "2025-03-23",
+		Time:             "07:00:00Z",
+		FirstPractice:    &Session{Date: "2025-03-21", Time: "03:30:00Z"},
+		SprintQualifying: &Session{Date: "2025-03-21", Time: "07:30:00Z"},
+		Sprint:           &Session{Date: "2025-03-22", Time: "03:00:00Z"},
+		Qualifying:       &Session{Date: "2025-03-22", Time: "07:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "3",
+		URL:            "https://en.wikipedia.org/wiki/2025_Japanese_Grand_Prix",
+		RaceName:       "Japanese Grand Prix",
+		Circuit:        circuits["suzuka"],
+		Date:           "2025-04-06",
+		Time:           "05:00:00Z",
+		FirstPractice:  &Session{Date: "2025-04-04", Time: "02:30:00Z"},
+		SecondPractice: &Session{Date: "2025-04-04", Time: "06:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-04-05", Time: "02:30:00Z"},
+		Qualifying:     &Session{Date: "2025-04-05", Time: "06:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "4",
+		URL:            "https://en.wikipedia.org/wiki/2025_Bahrain_Grand_Prix",
+		RaceName:       "Bahrain Grand Prix",
+		Circuit:        circuits["bahrain"],
+		Date:           "2025-04-13",
+		Time:           "15:00:00Z",
+		FirstPractice:  &Session{Date: "2025-04-11", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-04-11", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-04-12", Time: "12:30:00Z"},
+		Qualifying:     &Session{Date: "2025-04-12", Time: "16:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "5",
+		URL:            "https://en.wikipedia.org/wiki/2025_Saudi_Arabian_Grand_Prix",
+		RaceName:       "Saudi Arabian Grand Prix",
+		Circuit:        circuits["jeddah"],
+		Date:           "2025-04-20",
+		Time:           "17:00:00Z",
+		FirstPractice:  &Session{Date: "2025-04-18", Time: "13:30:00Z"},
+		SecondPractice: &Session{Date: "2025-04-18", Time: "17:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-04-19", Time: "13:30:00Z"},
+		Qualifying:     &Session{Date: "2025-04-19", Time: "17:00:00Z"},
+	},
+	{
+		Season:           "2025",
+		Round:            "6",
+		URL:              "https://en.wikipedia.org/wiki/2025_Miami_Grand_Prix",
+		RaceName:         "Miami Grand Prix",
+		Circuit:          circuits["miami"],
+		Date:             "2025-05-04",
+		Time:             "20:00:00Z",
+		FirstPractice:    &Session{Date: "2025-05-02", Time: "16:30:00Z"},
+		SprintQualifying: &Session{Date: "2025-05-02", Time: "20:30:00Z"},
+		Sprint:           &Session{Date: "2025-05-03", Time: "16:00:00Z"},
+		Qualifying:       &Session{Date: "2025-05-03", Time: "20:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "7",
+		URL:            "https://en.wikipedia.org/wiki/2025_Emilia_Romagna_Grand_Prix",
+		RaceName:       "Emilia Romagna Grand Prix",
+		Circuit:        circuits["imola"],
+		Date:           "2025-05-18",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-05-16", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-05-16", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-05-17", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-05-17", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "8",
+		URL:            "https://en.wikipedia.org/wiki/2025_Monaco_Grand_Prix",
+		RaceName:       "Monaco Grand Prix",
+		Circuit:        circuits["monaco"],
+		Date:           "2025-05-25",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-05-23", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-05-23", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-05-24", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-05-24", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "9",
+		URL:            "https://en.wikipedia.org/wiki/2025_Spanish_Grand_Prix",
+		RaceName:       "Spanish Grand Prix",
+		Circuit:        circuits["catalunya"],
+		Date:           "2025-06-01",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-05-30", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-05-30", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-05-31", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-05-31", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "10",
+		URL:            "https://en.wikipedia.org/wiki/2025_Canadian_Grand_Prix",
+		RaceName:       "Canadian Grand Prix",
+		Circuit:        circuits["villeneuve"],
+		Date:           "2025-06-15",
+		Time:           "18:00:00Z",
+		FirstPractice:  &Session{Date: "2025-06-13", Time: "17:30:00Z"},
+		SecondPractice: &Session{Date: "2025-06-13", Time: "21:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-06-14", Time: "16:30:00Z"},
+		Qualifying:     &Session{Date: "2025-06-14", Time: "20:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "11",
+		URL:            "https://en.wikipedia.org/wiki/2025_Austrian_Grand_Prix",
+		RaceName:       "Austrian Grand Prix",
+		Circuit:        circuits["red_bull_ring"],
+		Date:           "2025-06-29",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-06-27", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-06-27", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-06-28", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-06-28", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "12",
+		URL:            "https://en.wikipedia.org/wiki/2025_British_Grand_Prix",
+		RaceName:       "British Grand Prix",
+		Circuit:        circuits["silverstone"],
+		Date:           "2025-07-06",
+		Time:           "14:00:00Z",
+		FirstPractice:  &Session{Date: "2025-07-04", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-07-04", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-07-05", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-07-05", Time: "14:00:00Z"},
+	},
+	{
+		Season:           "2025",
+		Round:            "13",
+		URL:              "https://en.wikipedia.org/wiki/2025_Belgian_Grand_Prix",
+		RaceName:         "Belgian Grand Prix",
+		Circuit:          circuits["spa"],
+		Date:             "2025-07-27",
+		Time:             "13:00:00Z",
+		FirstPractice:    &Session{Date: "2025-07-25", Time: "10:30:00Z"},
+		SprintQualifying: &Session{Date: "2025-07-25", Time: "14:30:00Z"},
+		Sprint:           &Session{Date: "2025-07-26", Time: "10:00:00Z"},
+		Qualifying:       &Session{Date: "2025-07-26", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "14",
+		URL:            "https://en.wikipedia.org/wiki/2025_Hungarian_Grand_Prix",
+		RaceName:       "Hungarian Grand Prix",
+		Circuit:        circuits["hungaroring"],
+		Date:           "2025-08-03",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-08-01", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-08-01", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-08-02", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-08-02", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "15",
+		URL:            "https://en.wikipedia.org/wiki/2025_Dutch_Grand_Prix",
+		RaceName:       "Dutch Grand Prix",
+		Circuit:        circuits["zandvoort"],
+		Date:           "2025-08-31",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-08-29", Time: "10:30:00Z"},
+		SecondPractice: &Session{Date: "2025-08-29", Time: "14:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-08-30", Time: "09:30:00Z"},
+		Qualifying:     &Session{Date: "2025-08-30", Time: "13:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "16",
+		URL:            "https://en.wikipedia.org/wiki/2025_Italian_Grand_Prix",
+		RaceName:       "Italian Grand Prix",
+		Circuit:        circuits["monza"],
+		Date:           "2025-09-07",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-09-05", Time: "11:30:00Z"},
+		SecondPractice: &Session{Date: "2025-09-05", Time: "15:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-09-06", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-09-06", Time: "14:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "17",
+		URL:            "https://en.wikipedia.org/wiki/2025_Azerbaijan_Grand_Prix",
+		RaceName:       "Azerbaijan Grand Prix",
+		Circuit:        circuits["baku"],
+		Date:           "2025-09-21",
+		Time:           "11:00:00Z",
+		FirstPractice:  &Session{Date: "2025-09-19", Time: "08:30:00Z"},
+		SecondPractice: &Session{Date: "2025-09-19", Time: "12:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-09-20", Time: "08:30:00Z"},
+		Qualifying:     &Session{Date: "2025-09-20", Time: "12:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "18",
+		URL:            "https://en.wikipedia.org/wiki/2025_Singapore_Grand_Prix",
+		RaceName:       "Singapore Grand Prix",
+		Circuit:        circuits["marina_bay"],
+		Date:           "2025-10-05",
+		Time:           "12:00:00Z",
+		FirstPractice:  &Session{Date: "2025-10-03", Time: "09:30:00Z"},
+		SecondPractice: &Session{Date: "2025-10-03", Time: "13:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-10-04", Time: "09:30:00Z"},
+		Qualifying:     &Session{Date: "2025-10-04", Time: "13:00:00Z"},
+	},
+	{
+		Season:           "2025",
+		Round:            "19",
+		URL:              "https://en.wikipedia.org/wiki/2025_United_States_Grand_Prix",
+		RaceName:         "United States Grand Prix",
+		Circuit:          circuits["americas"],
+		Date:             "2025-10-19",
+		Time:             "19:00:00Z",
+		FirstPractice:    &Session{Date: "2025-10-17", Time: "17:30:00Z"},
+		SprintQualifying: &Session{Date: "2025-10-17", Time: "21:30:00Z"},
+		Sprint:           &Session{Date: "2025-10-18", Time: "17:00:00Z"},
+		Qualifying:       &Session{Date: "2025-10-18", Time: "21:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "20",
+		URL:            "https://en.wikipedia.org/wiki/2025_Mexico_City_Grand_Prix",
+		RaceName:       "Mexico City Grand Prix",
+		Circuit:        circuits["rodriguez"],
+		Date:           "2025-10-26",
+		Time:           "20:00:00Z",
+		FirstPractice:  &Session{Date: "2025-10-24", Time: "18:30:00Z"},
+		SecondPractice: &Session{Date: "2025-10-24", Time: "22:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-10-25", Time: "17:30:00Z"},
+		Qualifying:     &Session{Date: "2025-10-25", Time: "21:00:00Z"},
+	},
+	{
+		Season:           "2025",
+		Round:            "21",
+		URL:              "https://en.wikipedia.org/wiki/2025_São_Paulo_Grand_Prix",
+		RaceName:         "São Paulo Grand Prix",
+		Circuit:          circuits["interlagos"],
+		Date:             "2025-11-09",
+		Time:             "17:00:00Z",
+		FirstPractice:    &Session{Date: "2025-11-07", Time: "14:30:00Z"},
+		SprintQualifying: &Session{Date: "2025-11-07", Time: "18:30:00Z"},
+		Sprint:           &Session{Date: "2025-11-08", Time: "14:00:00Z"},
+		Qualifying:       &Session{Date: "2025-11-08", Time: "18:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "22",
+		URL:            "https://en.wikipedia.org/wiki/2025_Las_Vegas_Grand_Prix",
+		RaceName:       "Las Vegas Grand Prix",
+		Circuit:        circuits["vegas"],
+		Date:           "2025-11-23",
+		Time:           "04:00:00Z",
+		FirstPractice:  &Session{Date: "2025-11-21", Time: "02:30:00Z"},
+		SecondPractice: &Session{Date: "2025-11-21", Time: "06:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-11-22", Time: "02:30:00Z"},
+		Qualifying:     &Session{Date: "2025-11-22", Time: "06:00:00Z"},
+	},
+	{
+		Season:           "2025",
+		Round:            "23",
+		URL:              "https://en.wikipedia.org/wiki/2025_Qatar_Grand_Prix",
+		RaceName:         "Qatar Grand Prix",
+		Circuit:          circuits["losail"],
+		Date:             "2025-11-30",
+		Time:             "16:00:00Z",
+		FirstPractice:    &Session{Date: "2025-11-28", Time: "13:30:00Z"},
+		SprintQualifying: &Session{Date: "2025-11-28", Time: "17:30:00Z"},
+		Sprint:           &Session{Date: "2025-11-29", Time: "14:00:00Z"},
+		Qualifying:       &Session{Date: "2025-11-29", Time: "18:00:00Z"},
+	},
+	{
+		Season:         "2025",
+		Round:          "24",
+		URL:            "https://en.wikipedia.org/wiki/2025_Abu_Dhabi_Grand_Prix",
+		RaceName:       "Abu Dhabi Grand Prix",
+		Circuit:        circuits["yas_marina"],
+		Date:           "2025-12-07",
+		Time:           "13:00:00Z",
+		FirstPractice:  &Session{Date: "2025-12-05", Time: "09:30:00Z"},
+		SecondPractice: &Session{Date: "2025-12-05", Time: "13:00:00Z"},
+		ThirdPractice:  &Session{Date: "2025-12-06", Time: "10:30:00Z"},
+		Qualifying:     &Session{Date: "2025-12-06", Time: "14:00:00Z"},
+	},
+}
+
+// Races2024Data contains the 2024 calendar (race start times only)
+var Races2024Data = []Race{
+	{
+		Season:   "2024",
+		Round:    "1",
+		URL:      "https://en.wikipedia.org/wiki/2024_Bahrain_Grand_Prix",
+		RaceName: "Bahrain Grand Prix",
+		Circuit:  circuits["bahrain"],
+		Date:     "2024-03-02",
+		Time:     "15:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "2",
+		URL:      "https://en.wikipedia.org/wiki/2024_Saudi_Arabian_Grand_Prix",
+		RaceName: "Saudi Arabian Grand Prix",
+		Circuit:  circuits["jeddah"],
+		Date:     "2024-03-09",
+		Time:     "17:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "3",
+		URL:      "https://en.wikipedia.org/wiki/2024_Australian_Grand_Prix",
+		RaceName: "Australian Grand Prix",
+		Circuit:  circuits["albert_park"],
+		Date:     "2024-03-24",
+		Time:     "04:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "4",
+		URL:      "https://en.wikipedia.org/wiki/2024_Japanese_Grand_Prix",
+		RaceName: "Japanese Grand Prix",
+		Circuit:  circuits["suzuka"],
+		Date:     "2024-04-07",
+		Time:     "05:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "5",
+		URL:      "https://en.wikipedia.org/wiki/2024_Chinese_Grand_Prix",
+		RaceName: "Chinese Grand Prix",
+		Circuit:  circuits["shanghai"],
+		Date:     "2024-04-21",
+		Time:     "07:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "6",
+		URL:      "https://en.wikipedia.org/wiki/2024_Miami_Grand_Prix",
+		RaceName: "Miami Grand Prix",
+		Circuit:  circuits["miami"],
+		Date:     "2024-05-05",
+		Time:     "20:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "7",
+		URL:      "https://en.wikipedia.org/wiki/2024_Emilia_Romagna_Grand_Prix",
+		RaceName: "Emilia Romagna Grand Prix",
+		Circuit:  circuits["imola"],
+		Date:     "2024-05-19",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "8",
+		URL:      "https://en.wikipedia.org/wiki/2024_Monaco_Grand_Prix",
+		RaceName: "Monaco Grand Prix",
+		Circuit:  circuits["monaco"],
+		Date:     "2024-05-26",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "9",
+		URL:      "https://en.wikipedia.org/wiki/2024_Canadian_Grand_Prix",
+		RaceName: "Canadian Grand Prix",
+		Circuit:  circuits["villeneuve"],
+		Date:     "2024-06-09",
+		Time:     "18:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "10",
+		URL:      "https://en.wikipedia.org/wiki/2024_Spanish_Grand_Prix",
+		RaceName: "Spanish Grand Prix",
+		Circuit:  circuits["catalunya"],
+		Date:     "2024-06-23",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "11",
+		URL:      "https://en.wikipedia.org/wiki/2024_Austrian_Grand_Prix",
+		RaceName: "Austrian Grand Prix",
+		Circuit:  circuits["red_bull_ring"],
+		Date:     "2024-06-30",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "12",
+		URL:      "https://en.wikipedia.org/wiki/2024_British_Grand_Prix",
+		RaceName: "British Grand Prix",
+		Circuit:  circuits["silverstone"],
+		Date:     "2024-07-07",
+		Time:     "14:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "13",
+		URL:      "https://en.wikipedia.org/wiki/2024_Hungarian_Grand_Prix",
+		RaceName: "Hungarian Grand Prix",
+		Circuit:  circuits["hungaroring"],
+		Date:     "2024-07-21",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "14",
+		URL:      "https://en.wikipedia.org/wiki/2024_Belgian_Grand_Prix",
+		RaceName: "Belgian Grand Prix",
+		Circuit:  circuits["spa"],
+		Date:     "2024-07-28",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "15",
+		URL:      "https://en.wikipedia.org/wiki/2024_Dutch_Grand_Prix",
+		RaceName: "Dutch Grand Prix",
+		Circuit:  circuits["zandvoort"],
+		Date:     "2024-08-25",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "16",
+		URL:      "https://en.wikipedia.org/wiki/2024_Italian_Grand_Prix",
+		RaceName: "Italian Grand Prix",
+		Circuit:  circuits["monza"],
+		Date:     "2024-09-01",
+		Time:     "13:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "17",
+		URL:      "https://en.wikipedia.org/wiki/2024_Azerbaijan_Grand_Prix",
+		RaceName: "Azerbaijan Grand Prix",
+		Circuit:  circuits["baku"],
+		Date:     "2024-09-15",
+		Time:     "11:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "18",
+		URL:      "https://en.wikipedia.org/wiki/2024_Singapore_Grand_Prix",
+		RaceName: "Singapore Grand Prix",
+		Circuit:  circuits["marina_bay"],
+		Date:     "2024-09-22",
+		Time:     "12:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "19",
+		URL:      "https://en.wikipedia.org/wiki/2024_United_States_Grand_Prix",
+		RaceName: "United States Grand Prix",
+		Circuit:  circuits["americas"],
+		Date:     "2024-10-20",
+		Time:     "19:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "20",
+		URL:      "https://en.wikipedia.org/wiki/2024_Mexico_City_Grand_Prix",
+		RaceName: "Mexico City Grand Prix",
+		Circuit:  circuits["rodriguez"],
+		Date:     "2024-10-27",
+		Time:     "20:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "21",
+		URL:      "https://en.wikipedia.org/wiki/2024_São_Paulo_Grand_Prix",
+		RaceName: "São Paulo Grand Prix",
+		Circuit:  circuits["interlagos"],
+		Date:     "2024-11-03",
+		Time:     "15:30:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "22",
+		URL:      "https://en.wikipedia.org/wiki/2024_Las_Vegas_Grand_Prix",
+		RaceName: "Las Vegas Grand Prix",
+		Circuit:  circuits["vegas"],
+		Date:     "2024-11-24",
+		Time:     "06:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "23",
+		URL:      "https://en.wikipedia.org/wiki/2024_Qatar_Grand_Prix",
+		RaceName: "Qatar Grand Prix",
+		Circuit:  circuits["losail"],
+		Date:     "2024-12-01",
+		Time:     "16:00:00Z",
+	},
+	{
+		Season:   "2024",
+		Round:    "24",
+		URL:      "https://en.wikipedia.org/wiki/2024_Abu_Dhabi_Grand_Prix",
+		RaceName: "Abu Dhabi Grand Prix",
+		Circuit:  circuits["yas_marina"],
+		Date:     "2024-12-08",
+		Time:     "13:00:00Z",
+	},
+}
@@ -12,6 +12,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1996-03-23",
 		Nationality:     "Thai",
 		Team:            "Williams",
+		ConstructorID:   "williams",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -24,6 +25,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1981-07-29",
 		Nationality:     "Spanish",
 		Team:            "Aston Martin",
+		ConstructorID:   "aston_martin",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -36,6 +38,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2006-08-25",
 		Nationality:     "Italian",
 		Team:            "Mercedes",
+		ConstructorID:   "mercedes",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -48,6 +51,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2004-02-04",
 		Nationality:     "Estonian",
 		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -60,6 +64,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2005-05-08",
 		Nationality:     "British",
 		Team:            "Haas",
+		ConstructorID:   "haas",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -72,6 +77,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2004-10-14",
 		Nationality:     "Brazilian",
 		Team:            "Kick Sauber",
+		ConstructorID:   "sauber",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -84,6 +90,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2002-01-31",
 		Nationality:     "British",
 		Team:            "Williams",
+		ConstructorID:   "williams",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -96,6 +103,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2003-05-27",
 		Nationality:     "Argentine",
 		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -108,6 +116,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2005-05-02",
 		Nationality:     "American",
 		Team:            "Aston Martin",
+		ConstructorID:   "aston_martin",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -120,6 +129,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2003-01-20",
 		Nationality:     "Australian",
 		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
 		DriverType:      "Reserve Driver",
 	},
 	{
@@ -132,6 +142,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1996-02-07",
 		Nationality:     "French",
 		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -144,6 +155,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2004-09-28",
 		Nationality:     "French",
 		Team:            "Racing Bulls",
+		ConstructorID:   "rb",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -156,6 +168,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1985-01-07",
 		Nationality:     "British",
 		Team:            "Ferrari",
+		ConstructorID:   "ferrari",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -168,6 +181,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1994-03-07",
 		Nationality:     "Japanese",
 		Team:            "Haas",
+		ConstructorID:   "haas",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -180,6 +194,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1987-08-19",
 		Nationality:     "German",
 		Team:            "Kick Sauber",
+		ConstructorID:   "sauber",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -192,6 +207,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2001-09-22",
 		Nationality:     "Japanese",
 		Team:            "Racing Bulls",
+		ConstructorID:   "rb",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -204,6 +220,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2002-02-11",
 		Nationality:     "New Zealander",
 		Team:            "Racing Bulls",
+		ConstructorID:   "rb",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -216,6 +233,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1997-10-16",
 		Nationality:     "Monegasque",
 		Team:            "Ferrari",
+		ConstructorID:   "ferrari",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -228,6 +246,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2000-10-14",
 		Nationality:     "Monegasque",
 		Team:            "Ferrari",
+		ConstructorID:   "ferrari",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -240,6 +259,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2007-08-08",
 		Nationality:     "British",
 		Team:            "Racing Bulls",
+		ConstructorID:   "rb",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -252,6 +272,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1999-11-13",
 		Nationality:     "British",
 		Team:            "McLaren",
+		ConstructorID:   "mclaren",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -264,6 +285,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1996-09-17",
 		Nationality:     "French",
 		Team:            "Haas",
+		ConstructorID:   "haas",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -276,6 +298,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1999-05-06",
 		Nationality:     "Mexican",
 		Team:            "McLaren",
+		ConstructorID:   "mclaren",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -288,6 +311,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2001-04-06",
 		Nationality:     "Australian",
 		Team:            "McLaren",
+		ConstructorID:   "mclaren",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -300,6 +324,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1998-02-15",
 		Nationality:     "British",
 		Team:            "Mercedes",
+		ConstructorID:   "mercedes",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -312,6 +337,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1994-09-01",
 		Nationality:     "Spanish",
 		Team:            "Williams",
+		ConstructorID:   "williams",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -324,6 +350,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2005-03-07",
 		Nationality:     "British",
 		Team:            "Aston Martin",
+		ConstructorID:   "aston_martin",
 		DriverType:      "Test Driver",
 	},
 	{
@@ -336,6 +363,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1998-10-29",
 		Nationality:     "Canadian",
 		Team:            "Aston Martin",
+		ConstructorID:   "aston_martin",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -348,6 +376,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "2000-05-11",
 		Nationality:     "Japanese",
 		Team:            "Red Bull",
+		ConstructorID:   "red_bull",
 		DriverType:      "Race Driver",
 	},
 	{
@@ -360,6 +389,7 @@ var DriversData = []Driver{
 		DateOfBirth:     "1997-09-30",
 		Nationality:     "Dutch",
 		Team:            "Red Bull",
+		ConstructorID:   "red_bull",
 		DriverType:      "Race Driver",
 	},
 }
@@ -372,6 +402,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/alpine/2025alpinecarright.webp",
 		Name:          "Alpine F1 Team",
 		Nationality:   "French",
+		Engine:        "Renault",
 		TeamColor:     "#005081",
 	},
 	{
@@ -380,6 +411,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/astonmartin/2025astonmartincarright.webp",
 		Name:          "Aston Martin",
 		Nationality:   "British",
+		Engine:        "Mercedes",
 		TeamColor:     "#00482C",
 	},
 	{
@@ -388,6 +420,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/ferrari/2025ferraricarright.webp",
 		Name:          "Ferrari",
 		Nationality:   "Italian",
+		Engine:        "Ferrari",
 		TeamColor:     "#710006",
 	},
 	{
@@ -396,6 +429,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/haas/2025haascarright.webp",
 		Name:          "Haas F1 Team",
 		Nationality:   "American",
+		Engine:        "Ferrari",
 		TeamColor:     "#4D5052",
 	},
 	{
@@ -404,6 +438,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/mclaren/2025mclarencarright.webp",
 		Name:          "McLaren",
 		Nationality:   "British",
+		Engine:        "Mercedes",
 		TeamColor:     "#863400",
 	},
 	{
@@ -412,6 +447,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/mercedes/2025mercedescarright.webp",
 		Name:          "Mercedes",
 		Nationality:   "German",
+		Engine:        "Mercedes",
 		TeamColor:     "#007560",
 	},
 	{
@@ -420,6 +456,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/racingbulls/2025racingbullscarright.webp",
 		Name:          "Racing Bulls",
 		Nationality:   "Italian",
+		Engine:        "Honda RBPT",
 		TeamColor:     "#2345AB",
 	},
 	{
@@ -428,6 +465,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/redbullracing/2025redbullracingcarright.webp",
 		Name:          "Red Bull Racing",
 		Nationality:   "Austrian",
+		Engine:        "Honda RBPT",
 		TeamColor:     "#003282",
 	},
 	{
@@ -436,6 +474,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/kicksauber/2025kicksaubercarright.webp",
 		Name:          "Kick Sauber",
 		Nationality:   "Swiss",
+		Engine:        "Ferrari",
 		TeamColor:     "#006300",
 	},
 	{
@@ -444,6 +483,7 @@ var ConstructorsData = []Constructor{
 		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/williams/2025williamscarright.webp",
 		Name:          "Williams",
 		Nationality:   "British",
+		Engine:        "Mercedes",
 		TeamColor:     "#000681",
 	},
 }
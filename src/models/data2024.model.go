@@ -0,0 +1,387 @@
+package models
+
+// Drivers2024Data contains the drivers of the 2024 season (no portraits are bundled for this season)
+var Drivers2024Data = []Driver{
+	{
+		DriverID:        "albon",
+		PermanentNumber: "23",
+		Code:            "ALB",
+		GivenName:       "Alexander",
+		FamilyName:      "Albon",
+		DateOfBirth:     "1996-03-23",
+		Nationality:     "Thai",
+		Team:            "Williams",
+		ConstructorID:   "williams",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "alonso",
+		PermanentNumber: "14",
+		Code:            "ALO",
+		GivenName:       "Fernando",
+		FamilyName:      "Alonso",
+		DateOfBirth:     "1981-07-29",
+		Nationality:     "Spanish",
+		Team:            "Aston Martin",
+		ConstructorID:   "aston_martin",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "bearman",
+		PermanentNumber: "38",
+		Code:            "BEA",
+		GivenName:       "Oliver",
+		FamilyName:      "Bearman",
+		DateOfBirth:     "2005-05-08",
+		Nationality:     "British",
+		Team:            "Haas F1 Team",
+		ConstructorID:   "haas",
+		DriverType:      "Reserve Driver",
+	},
+	{
+		DriverID:        "bottas",
+		PermanentNumber: "77",
+		Code:            "BOT",
+		GivenName:       "Valtteri",
+		FamilyName:      "Bottas",
+		DateOfBirth:     "1989-08-28",
+		Nationality:     "Finnish",
+		Team:            "Sauber",
+		ConstructorID:   "sauber",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "colapinto",
+		PermanentNumber: "43",
+		Code:            "COL",
+		GivenName:       "Franco",
+		FamilyName:      "Colapinto",
+		DateOfBirth:     "2003-05-27",
+		Nationality:     "Argentine",
+		Team:            "Williams",
+		ConstructorID:   "williams",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "doohan",
+		PermanentNumber: "7",
+		Code:            "DOO",
+		GivenName:       "Jack",
+		FamilyName:      "Doohan",
+		DateOfBirth:     "2003-01-20",
+		Nationality:     "Australian",
+		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
+		DriverType:      "Reserve Driver",
+	},
+	{
+		DriverID:        "gasly",
+		PermanentNumber: "10",
+		Code:            "GAS",
+		GivenName:       "Pierre",
+		FamilyName:      "Gasly",
+		DateOfBirth:     "1996-02-07",
+		Nationality:     "French",
+		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "hamilton",
+		PermanentNumber: "44",
+		Code:            "HAM",
+		GivenName:       "Lewis",
+		FamilyName:      "Hamilton",
+		DateOfBirth:     "1985-01-07",
+		Nationality:     "British",
+		Team:            "Mercedes",
+		ConstructorID:   "mercedes",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "hulkenberg",
+		PermanentNumber: "27",
+		Code:            "HUL",
+		GivenName:       "Nico",
+		FamilyName:      "Hülkenberg",
+		DateOfBirth:     "1987-08-19",
+		Nationality:     "German",
+		Team:            "Haas F1 Team",
+		ConstructorID:   "haas",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "kevin_magnussen",
+		PermanentNumber: "20",
+		Code:            "MAG",
+		GivenName:       "Kevin",
+		FamilyName:      "Magnussen",
+		DateOfBirth:     "1992-10-05",
+		Nationality:     "Danish",
+		Team:            "Haas F1 Team",
+		ConstructorID:   "haas",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "lawson",
+		PermanentNumber: "30",
+		Code:            "LAW",
+		GivenName:       "Liam",
+		FamilyName:      "Lawson",
+		DateOfBirth:     "2002-02-11",
+		Nationality:     "New Zealander",
+		Team:            "RB F1 Team",
+		ConstructorID:   "rb",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "leclerc",
+		PermanentNumber: "16",
+		Code:            "LEC",
+		GivenName:       "Charles",
+		FamilyName:      "Leclerc",
+		DateOfBirth:     "1997-10-16",
+		Nationality:     "Monegasque",
+		Team:            "Ferrari",
+		ConstructorID:   "ferrari",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "norris",
+		PermanentNumber: "4",
+		Code:            "NOR",
+		GivenName:       "Lando",
+		FamilyName:      "Norris",
+		DateOfBirth:     "1999-11-13",
+		Nationality:     "British",
+		Team:            "McLaren",
+		ConstructorID:   "mclaren",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "ocon",
+		PermanentNumber: "31",
+		Code:            "OCO",
+		GivenName:       "Esteban",
+		FamilyName:      "Ocon",
+		DateOfBirth:     "1996-09-17",
+		Nationality:     "French",
+		Team:            "Alpine F1 Team",
+		ConstructorID:   "alpine",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "perez",
+		PermanentNumber: "11",
+		Code:            "PER",
+		GivenName:       "Sergio",
+		FamilyName:      "Pérez",
+		DateOfBirth:     "1990-01-26",
+		Nationality:     "Mexican",
+		Team:            "Red Bull",
+		ConstructorID:   "red_bull",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "piastri",
+		PermanentNumber: "81",
+		Code:            "PIA",
+		GivenName:       "Oscar",
+		FamilyName:      "Piastri",
+		DateOfBirth:     "2001-04-06",
+		Nationality:     "Australian",
+		Team:            "McLaren",
+		ConstructorID:   "mclaren",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "ricciardo",
+		PermanentNumber: "3",
+		Code:            "RIC",
+		GivenName:       "Daniel",
+		FamilyName:      "Ricciardo",
+		DateOfBirth:     "1989-07-01",
+		Nationality:     "Australian",
+		Team:            "RB F1 Team",
+		ConstructorID:   "rb",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "russell",
+		PermanentNumber: "63",
+		Code:            "RUS",
+		GivenName:       "George",
+		FamilyName:      "Russell",
+		DateOfBirth:     "1998-02-15",
+		Nationality:     "British",
+		Team:            "Mercedes",
+		ConstructorID:   "mercedes",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "sainz",
+		PermanentNumber: "55",
+		Code:            "SAI",
+		GivenName:       "Carlos",
+		FamilyName:      "Sainz",
+		DateOfBirth:     "1994-09-01",
+		Nationality:     "Spanish",
+		Team:            "Ferrari",
+		ConstructorID:   "ferrari",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "sargeant",
+		PermanentNumber: "2",
+		Code:            "SAR",
+		GivenName:       "Logan",
+		FamilyName:      "Sargeant",
+		DateOfBirth:     "2000-12-31",
+		Nationality:     "American",
+		Team:            "Williams",
+		ConstructorID:   "williams",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "stroll",
+		PermanentNumber: "18",
+		Code:            "STR",
+		GivenName:       "Lance",
+		FamilyName:      "Stroll",
+		DateOfBirth:     "1998-10-29",
+		Nationality:     "Canadian",
+		Team:            "Aston Martin",
+		ConstructorID:   "aston_martin",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "tsunoda",
+		PermanentNumber: "22",
+		Code:            "TSU",
+		GivenName:       "Yuki",
+		FamilyName:      "Tsunoda",
+		DateOfBirth:     "2000-05-11",
+		Nationality:     "Japanese",
+		Team:            "RB F1 Team",
+		ConstructorID:   "rb",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "max_verstappen",
+		PermanentNumber: "1",
+		Code:            "VER",
+		GivenName:       "Max",
+		FamilyName:      "Verstappen",
+		DateOfBirth:     "1997-09-30",
+		Nationality:     "Dutch",
+		Team:            "Red Bull",
+		ConstructorID:   "red_bull",
+		DriverType:      "Race Driver",
+	},
+	{
+		DriverID:        "zhou",
+		PermanentNumber: "24",
+		Code:            "ZHO",
+		GivenName:       "Guanyu",
+		FamilyName:      "Zhou",
+		DateOfBirth:     "1999-05-30",
+		Nationality:     "Chinese",
+		Team:            "Sauber",
+		ConstructorID:   "sauber",
+		DriverType:      "Race Driver",
+	},
+}
+
+// Constructors2024Data contains the constructors of the 2024 season
+var Constructors2024Data = []Constructor{
+	{
+		ConstructorID: "alpine",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/alpine/2025alpinelogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/alpine/2025alpinecarright.webp",
+		Name:          "Alpine F1 Team",
+		Nationality:   "French",
+		Engine:        "Renault",
+		TeamColor:     "#005081",
+	},
+	{
+		ConstructorID: "aston_martin",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/astonmartin/2025astonmartinlogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/astonmartin/2025astonmartincarright.webp",
+		Name:          "Aston Martin",
+		Nationality:   "British",
+		Engine:        "Mercedes",
+		TeamColor:     "#00482C",
+	},
+	{
+		ConstructorID: "ferrari",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/ferrari/2025ferrarilogolight.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/ferrari/2025ferraricarright.webp",
+		Name:          "Ferrari",
+		Nationality:   "Italian",
+		Engine:        "Ferrari",
+		TeamColor:     "#710006",
+	},
+	{
+		ConstructorID: "haas",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/haas/2025haaslogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/haas/2025haascarright.webp",
+		Name:          "Haas F1 Team",
+		Nationality:   "American",
+		Engine:        "Ferrari",
+		TeamColor:     "#4D5052",
+	},
+	{
+		ConstructorID: "mclaren",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/mclaren/2025mclarenlogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/mclaren/2025mclarencarright.webp",
+		Name:          "McLaren",
+		Nationality:   "British",
+		Engine:        "Mercedes",
+		TeamColor:     "#863400",
+	},
+	{
+		ConstructorID: "mercedes",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/mercedes/2025mercedeslogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/mercedes/2025mercedescarright.webp",
+		Name:          "Mercedes",
+		Nationality:   "German",
+		Engine:        "Mercedes",
+		TeamColor:     "#007560",
+	},
+	{
+		ConstructorID: "rb",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/racingbulls/2025racingbullslogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/racingbulls/2025racingbullscarright.webp",
+		Name:          "RB F1 Team",
+		Nationality:   "Italian",
+		Engine:        "Honda RBPT",
+		TeamColor:     "#2345AB",
+	},
+	{
+		ConstructorID: "red_bull",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/redbullracing/2025redbullracinglogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/redbullracing/2025redbullracingcarright.webp",
+		Name:          "Red Bull",
+		Nationality:   "Austrian",
+		Engine:        "Honda RBPT",
+		TeamColor:     "#003282",
+	},
+	{
+		ConstructorID: "sauber",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/kicksauber/2025kicksauberlogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/kicksauber/2025kicksaubercarright.webp",
+		Name:          "Sauber",
+		Nationality:   "Swiss",
+		Engine:        "Ferrari",
+		TeamColor:     "#006300",
+	},
+	{
+		ConstructorID: "williams",
+		Icon:          "https://media.formula1.com/image/upload/c_fit,h_64/q_auto/v1740000000/common/f1/2025/williams/2025williamslogowhite.webp",
+		Image:         "https://media.formula1.com/image/upload/c_lfill,w_3392/q_auto/v1740000000/common/f1/2025/williams/2025williamscarright.webp",
+		Name:          "Williams",
+		Nationality:   "British",
+		Engine:        "Mercedes",
+		TeamColor:     "#000681",
+	},
+}
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"encoding/xml"
+	"time"
+)
+
 // PageData
 // Structure pour passer les données aux templates HTML.
 type PageData struct {
@@ -11,39 +16,66 @@ type PageData struct {
 
 // Driver
 // Structure représentant un pilote F1 avec ses données personnelles et sa écurie.
+// Les tags xml suivent le format Ergast (identifiants en attributs, données en éléments).
+// Team est le nom affiché de l'écurie, ConstructorID la référence vers Constructor utilisée pour les liens.
 type Driver struct {
-	DriverID        string `json:"driverId"`
-	PermanentNumber string `json:"permanentNumber"`
-	Code            string `json:"code"`
-	Image           string `json:"image"`
-	GivenName       string `json:"givenName"`
-	FamilyName      string `json:"familyName"`
-	DateOfBirth     string `json:"dateOfBirth"`
-	Nationality     string `json:"nationality"`
-	Team            string `json:"team"`
-	DriverType      string `json:"driverType"`
+	DriverID        string `json:"driverId" xml:"driverId,attr"`
+	PermanentNumber string `json:"permanentNumber" xml:"PermanentNumber,omitempty"`
+	Code            string `json:"code" xml:"code,attr,omitempty"`
+	URL             string `json:"url,omitempty" xml:"url,attr,omitempty"`
+	Image           string `json:"image" xml:"Image,omitempty"`
+	GivenName       string `json:"givenName" xml:"GivenName"`
+	FamilyName      string `json:"familyName" xml:"FamilyName"`
+	DateOfBirth     string `json:"dateOfBirth" xml:"DateOfBirth,omitempty"`
+	Nationality     string `json:"nationality" xml:"Nationality,omitempty"`
+	Team            string `json:"team" xml:"Team,omitempty"`
+	ConstructorID   string `json:"constructorId,omitempty" xml:"constructorId,attr,omitempty"`
+	DriverType      string `json:"driverType" xml:"DriverType,omitempty"`
 }
 
 // Constructor
 // Structure représentant une écurie F1 avec ses informations visuelles et son identifiant.
 type Constructor struct {
-	ConstructorID string `json:"constructorId"`
-	Icon          string `json:"icon"`
-	Image         string `json:"image"`
-	Name          string `json:"name"`
-	Nationality   string `json:"nationality"`
-	TeamColor     string `json:"teamColor"`
+	ConstructorID string `json:"constructorId" xml:"constructorId,attr"`
+	URL           string `json:"url,omitempty" xml:"url,attr,omitempty"`
+	Icon          string `json:"icon" xml:"Icon,omitempty"`
+	Image         string `json:"image" xml:"Image,omitempty"`
+	Name          string `json:"name" xml:"Name"`
+	Nationality   string `json:"nationality" xml:"Nationality,omitempty"`
+	Engine        string `json:"engine,omitempty" xml:"Engine,omitempty"`
+	TeamColor     string `json:"teamColor" xml:"TeamColor,omitempty"`
+}
+
+// DriverTable
+// Structure représentant la table des pilotes d'une réponse API F1 pour une saison.
+type DriverTable struct {
+	Season   string   `json:"season" xml:"season,attr"`
+	DriverID string   `json:"driverId,omitempty" xml:"driverId,attr,omitempty"`
+	Drivers  []Driver `json:"Drivers" xml:"Driver"`
+}
+
+// ConstructorTable
+// Structure représentant la table des écuries d'une réponse API F1 pour une saison.
+type ConstructorTable struct {
+	Season        string        `json:"season" xml:"season,attr"`
+	ConstructorID string        `json:"constructorId,omitempty" xml:"constructorId,attr,omitempty"`
+	Constructors  []Constructor `json:"Constructors" xml:"Constructor"`
 }
 
 // MRData
-// Structure contenant les métadonnées de la réponse API F1.
+// Structure contenant les métadonnées de la réponse API F1 et la table de données associée.
+// Elle sert aussi d'élément racine <MRData> pour la sortie XML au format Ergast (le calendrier n'y est pas sérialisé).
 type MRData struct {
-	Xmlns  string `json:"xmlns"`
-	Series string `json:"series"`
-	URL    string `json:"url"`
-	Limit  string `json:"limit"`
-	Offset string `json:"offset"`
-	Total  string `json:"total"`
+	XMLName          xml.Name          `json:"-" xml:"MRData"`
+	Xmlns            string            `json:"xmlns" xml:"xmlns,attr"`
+	Series           string            `json:"series" xml:"series,attr"`
+	URL              string            `json:"url" xml:"url,attr"`
+	Limit            string            `json:"limit" xml:"limit,attr"`
+	Offset           string            `json:"offset" xml:"offset,attr"`
+	Total            string            `json:"total" xml:"total,attr"`
+	DriverTable      *DriverTable      `json:"DriverTable,omitempty" xml:"DriverTable,omitempty"`
+	ConstructorTable *ConstructorTable `json:"ConstructorTable,omitempty" xml:"ConstructorTable,omitempty"`
+	RaceTable        *RaceTable        `json:"RaceTable,omitempty" xml:"-"`
 }
 
 // F1Response
@@ -53,8 +85,115 @@ type F1Response struct {
 }
 
 // Favorites
-// Structure pour stocker les pilotes et écuries favoris de l'utilisateur.
+// Structure pour stocker les pilotes et écuries favoris de l'utilisateur, rangés en collections ordonnées.
+// Drivers et Constructors sont déduits des collections (éléments présents dans au moins une d'entre elles).
 type Favorites struct {
-	Drivers      []string `json:"drivers"`
-	Constructors []string `json:"constructors"`
+	Drivers      []string             `json:"drivers"`
+	Constructors []string             `json:"constructors"`
+	Collections  []FavoriteCollection `json:"collections"`
+}
+
+// FavoriteCollection
+// Collection nommée de favoris ("My fantasy picks"), dans l'ordre choisi par l'utilisateur.
+// La première collection est la collection par défaut, qui reçoit les ajouts sans collection précisée.
+type FavoriteCollection struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Items     []FavoriteItem `json:"items"`
+}
+
+// FavoriteItem
+// Pilote ou écurie d'une collection, avec une note libre, des tags et sa date d'ajout.
+// PreviousID conserve l'ancien identifiant d'une écurie renommée (remplacé au chargement via la table d'alias).
+type FavoriteItem struct {
+	Kind       string    `json:"kind"`
+	ID         string    `json:"id"`
+	PreviousID string    `json:"previousId,omitempty"`
+	Note       string    `json:"note"`
+	Tags       []string  `json:"tags"`
+	AddedAt    time.Time `json:"addedAt"`
+}
+
+// FavoriteEntry
+// Élément affiché sur la page des favoris : favori enregistré et fiche correspondante de la saison
+// (Driver ou Constructor, nil si l'élément n'existe pas dans la saison).
+// Orphaned indique un élément absent de toutes les saisons ("no longer on the grid").
+type FavoriteEntry struct {
+	Item         FavoriteItem
+	CollectionID string
+	Position     int
+	Name         string
+	Driver       *Driver
+	Constructor  *Constructor
+	Orphaned     bool
+}
+
+// FavoriteGroup
+// Groupe d'éléments de la page des favoris (collection, type ou tag selon le regroupement choisi).
+// Collection n'est renseignée que pour un regroupement par collection.
+type FavoriteGroup struct {
+	Title      string
+	Collection *FavoriteCollection
+	Entries    []FavoriteEntry
+}
+
+// FavoritesFile
+// Contenu du fichier favorites.json : favoris de chaque propriétaire ("user:<id>", "anon:<id>")
+// et liens de partage en lecture seule.
+type FavoritesFile struct {
+	Owners map[string]*Favorites `json:"owners"`
+	Shares []FavoritesShare      `json:"shares,omitempty"`
+}
+
+// FavoritesShare
+// Lien de partage en lecture seule des favoris d'un propriétaire (/shared/<jeton>), un seul par propriétaire.
+type FavoritesShare struct {
+	Token     string    `json:"token"`
+	Owner     string    `json:"owner"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// FavoritesExport
+// Contenu d'un export JSON des favoris (relu par l'import) : version du format, date et collections.
+type FavoritesExport struct {
+	Version     int                  `json:"version"`
+	ExportedAt  time.Time            `json:"exportedAt"`
+	Collections []FavoriteCollection `json:"collections"`
+}
+
+// FavoriteEvent
+// Événement du journal des favoris (ajout, retrait ou état de référence), jamais modifié une fois enregistré.
+// Actor est le nom du compte à l'origine du changement (vide pour un visiteur anonyme). Undoes désigne
+// l'événement annulé par celui-ci ; Snapshot porte l'état complet des favoris pour une action "snapshot".
+type FavoriteEvent struct {
+	ID         int64               `json:"id"`
+	Owner      string              `json:"owner"`
+	Actor      string              `json:"actor,omitempty"`
+	Action     string              `json:"action"`
+	Kind       string              `json:"kind,omitempty"`
+	ItemID     string              `json:"itemId,omitempty"`
+	Placements []FavoritePlacement `json:"placements,omitempty"`
+	Undoes     int64               `json:"undoes,omitempty"`
+	Snapshot   *Favorites          `json:"snapshot,omitempty"`
+	At         time.Time           `json:"at"`
+}
+
+// FavoritePlacement
+// Emplacement d'un élément ajouté ou retiré : collection, position (1 = en tête) et élément complet
+// (note, tags et date d'ajout sont ainsi restaurés par une annulation).
+type FavoritePlacement struct {
+	Collection     string       `json:"collection"`
+	CollectionName string       `json:"collectionName"`
+	Position       int          `json:"position"`
+	Item           FavoriteItem `json:"item"`
+}
+
+// FavoriteHistoryEntry
+// Événement affiché sur la page de l'historique, avec le nom de l'élément et son état d'annulation.
+type FavoriteHistoryEntry struct {
+	Event    FavoriteEvent
+	Name     string
+	Undone   bool
+	Undoable bool
 }
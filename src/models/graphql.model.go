@@ -0,0 +1,31 @@
+package models
+
+// GraphQLRequest
+// Structure d'une requête GraphQL (corps JSON d'un POST ou paramètres d'un GET sur /graphql).
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLLocation
+// Position (ligne, colonne) d'une erreur dans le texte de la requête.
+type GraphQLLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLError
+// Structure décrivant une erreur GraphQL (message, positions et chemin du champ en erreur).
+type GraphQLError struct {
+	Message   string            `json:"message"`
+	Locations []GraphQLLocation `json:"locations,omitempty"`
+	Path      []interface{}     `json:"path,omitempty"`
+}
+
+// GraphQLResponse
+// Réponse de /graphql : données demandées et/ou erreurs.
+type GraphQLResponse struct {
+	Data   interface{}    `json:"data,omitempty"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// RouteDoc
+// Structure décrivant une opération HTTP d'une route enregistrée (source de la spécification OpenAPI).
+type RouteDoc struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Description string
+	Tag         string
+	Params      []ParamDoc
+	// Form décrit les champs d'un corps application/x-www-form-urlencoded (actions POST).
+	Form []ParamDoc
+	// Multipart indique que Form est envoyé en multipart/form-data (envoi de fichier).
+	Multipart bool
+	// RequestBody est une valeur du type attendu dans un corps application/json (schéma déduit par réflexion).
+	RequestBody interface{}
+	// ContentTypes liste les formats de la réponse 200 ("text/html", "application/json", "application/xml"...).
+	ContentTypes []string
+	// Headers décrit les en-têtes de la réponse 200 (Link, X-Total-Count...).
+	Headers []ParamDoc
+	// Response est une valeur du type renvoyé, dont le schéma est déduit par réflexion (nil pour du HTML).
+	Response interface{}
+	// Redirect indique que l'opération répond par une redirection 303 au lieu d'une page.
+	Redirect bool
+	// RedirectTo décrit la cible de la redirection (par défaut : returnUrl, ou / s'il est vide).
+	RedirectTo string
+	Errors     []int
+}
+
+// ParamDoc
+// Structure décrivant un paramètre d'opération (chemin, query ou champ de formulaire).
+type ParamDoc struct {
+	Name        string
+	In          string
+	Description string
+	Type        string
+	Required    bool
+	Enum        []string
+	Default     string
+	// Format précise le type ("binary" pour un fichier envoyé dans un formulaire multipart).
+	Format string
+	// Repeated indique un paramètre de query répétable (?team=Ferrari&team=McLaren), documenté comme un tableau.
+	Repeated bool
+}
+
+// OrderedMap
+// Objet JSON/YAML dont les clés conservent leur ordre d'insertion (lisibilité de la spécification).
+type OrderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// NewOrderedMap
+// Crée un objet ordonné vide.
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{values: map[string]interface{}{}}
+}
+
+// Set
+// Ajoute ou remplace une clé (une nouvelle clé est placée en dernier) et retourne l'objet pour chaîner les appels.
+func (m *OrderedMap) Set(key string, value interface{}) *OrderedMap {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+	return m
+}
+
+// Get
+// Retourne la valeur associée à une clé.
+func (m *OrderedMap) Get(key string) (interface{}, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Keys
+// Retourne les clés dans leur ordre d'insertion.
+func (m *OrderedMap) Keys() []string {
+	return m.keys
+}
+
+// MarshalJSON
+// Encode l'objet en JSON en respectant l'ordre des clés.
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,55 @@
+package models
+
+// Location
+// Structure représentant la position géographique d'un circuit.
+type Location struct {
+	Lat      string `json:"lat"`
+	Long     string `json:"long"`
+	Locality string `json:"locality"`
+	Country  string `json:"country"`
+}
+
+// Circuit
+// Structure représentant un circuit F1 avec son identifiant et sa localisation.
+type Circuit struct {
+	CircuitID   string   `json:"circuitId"`
+	URL         string   `json:"url,omitempty"`
+	CircuitName string   `json:"circuitName"`
+	Location    Location `json:"Location"`
+}
+
+// Session
+// Structure représentant la date et l'heure (UTC) d'une séance du week-end.
+type Session struct {
+	Date string `json:"date"`
+	Time string `json:"time,omitempty"`
+}
+
+// Race
+// Structure représentant un Grand Prix d'une saison avec son circuit et le programme des séances.
+type Race struct {
+	Season           string   `json:"season"`
+	Round            string   `json:"round"`
+	URL              string   `json:"url,omitempty"`
+	RaceName         string   `json:"raceName"`
+	Circuit          Circuit  `json:"Circuit"`
+	Date             string   `json:"date"`
+	Time             string   `json:"time,omitempty"`
+	FirstPractice    *Session `json:"FirstPractice,omitempty"`
+	SecondPractice   *Session `json:"SecondPractice,omitempty"`
+	ThirdPractice    *Session `json:"ThirdPractice,omitempty"`
+	SprintQualifying *Session `json:"SprintQualifying,omitempty"`
+	Sprint           *Session `json:"Sprint,omitempty"`
+	Qualifying       *Session `json:"Qualifying,omitempty"`
+
+	Results           []RaceResult       `json:"Results,omitempty"`
+	SprintResults     []RaceResult       `json:"SprintResults,omitempty"`
+	QualifyingResults []QualifyingResult `json:"QualifyingResults,omitempty"`
+}
+
+// RaceTable
+// Structure représentant la table des courses d'une réponse API F1 pour une saison.
+type RaceTable struct {
+	Season string `json:"season"`
+	Races  []Race `json:"Races"`
+}
@@ -0,0 +1,62 @@
+package models
+
+// ResultTime
+// Structure représentant un temps de course (millisecondes et texte, ex : "1:42:06.304" ou "+0.895").
+type ResultTime struct {
+	Millis string `json:"millis,omitempty"`
+	Time   string `json:"time"`
+}
+
+// AverageSpeed
+// Structure représentant la vitesse moyenne d'un tour.
+type AverageSpeed struct {
+	Units string `json:"units"`
+	Speed string `json:"speed"`
+}
+
+// FastestLap
+// Structure représentant le meilleur tour d'un pilote pendant une course.
+type FastestLap struct {
+	Rank         string        `json:"rank,omitempty"`
+	Lap          string        `json:"lap"`
+	Time         ResultTime    `json:"Time"`
+	AverageSpeed *AverageSpeed `json:"AverageSpeed,omitempty"`
+}
+
+// RaceResult
+// Structure représentant le résultat d'un pilote en course ou en sprint (table Results / SprintResults d'Ergast).
+type RaceResult struct {
+	Number       string      `json:"number"`
+	Position     string      `json:"position"`
+	PositionText string      `json:"positionText"`
+	Points       string      `json:"points"`
+	Driver       Driver      `json:"Driver"`
+	Constructor  Constructor `json:"Constructor"`
+	Grid         string      `json:"grid"`
+	Laps         string      `json:"laps"`
+	Status       string      `json:"status"`
+	Time         *ResultTime `json:"Time,omitempty"`
+	FastestLap   *FastestLap `json:"FastestLap,omitempty"`
+}
+
+// QualifyingResult
+// Structure représentant le résultat d'un pilote en qualifications (table QualifyingResults d'Ergast).
+type QualifyingResult struct {
+	Number      string      `json:"number"`
+	Position    string      `json:"position"`
+	Driver      Driver      `json:"Driver"`
+	Constructor Constructor `json:"Constructor"`
+	Q1          string      `json:"Q1,omitempty"`
+	Q2          string      `json:"Q2,omitempty"`
+	Q3          string      `json:"Q3,omitempty"`
+}
+
+// RoundResults
+// Structure regroupant les résultats d'une manche : course, sprint et qualifications.
+type RoundResults struct {
+	Race               []RaceResult
+	Sprint             []RaceResult
+	Qualifying         []QualifyingResult
+	RaceWinnerMillis   int
+	SprintWinnerMillis int
+}
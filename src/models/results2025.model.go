@@ -0,0 +1,125 @@
+package models
+
+// Results2025Data
+// Échantillon de résultats intégrés pour la saison 2025, indexé par numéro de manche.
+// Seuls les identifiants du pilote et de l'écurie sont renseignés : le service les complète depuis les données de la saison.
+// Pour les qualifications, seul le temps de la dernière séance disputée est conservé.
+// Les résultats complets de toutes les manches sont disponibles via la source Ergast (F1_DATA_SOURCE=ergast).
+var Results2025Data = map[string]RoundResults{
+	// Manche 1 : Australian Grand Prix
+	"1": {
+		Race: []RaceResult{
+			{Number: "4", Position: "1", PositionText: "1", Points: "25", Driver: Driver{DriverID: "norris"}, Constructor: Constructor{ConstructorID: "mclaren"}, Grid: "1", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6126304", Time: "1:42:06.304"}, FastestLap: &FastestLap{Rank: "1", Lap: "43", Time: ResultTime{Time: "1:22.167"}}},
+			{Number: "1", Position: "2", PositionText: "2", Points: "18", Driver: Driver{DriverID: "max_verstappen"}, Constructor: Constructor{ConstructorID: "red_bull"}, Grid: "3", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6127199", Time: "+0.895"}},
+			{Number: "63", Position: "3", PositionText: "3", Points: "15", Driver: Driver{DriverID: "russell"}, Constructor: Constructor{ConstructorID: "mercedes"}, Grid: "4", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6134785", Time: "+8.481"}},
+			{Number: "12", Position: "4", PositionText: "4", Points: "12", Driver: Driver{DriverID: "antonelli"}, Constructor: Constructor{ConstructorID: "mercedes"}, Grid: "16", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6136439", Time: "+10.135"}},
+			{Number: "23", Position: "5", PositionText: "5", Points: "10", Driver: Driver{DriverID: "albon"}, Constructor: Constructor{ConstructorID: "williams"}, Grid: "6", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6139077", Time: "+12.773"}},
+			{Number: "18", Position: "6", PositionText: "6", Points: "8", Driver: Driver{DriverID: "stroll"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Grid: "13", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6143717", Time: "+17.413"}},
+			{Number: "27", Position: "7", PositionText: "7", Points: "6", Driver: Driver{DriverID: "hulkenberg"}, Constructor: Constructor{ConstructorID: "sauber"}, Grid: "17", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6144727", Time: "+18.423"}},
+			{Number: "16", Position: "8", PositionText: "8", Points: "4", Driver: Driver{DriverID: "leclerc"}, Constructor: Constructor{ConstructorID: "ferrari"}, Grid: "7", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6146130", Time: "+19.826"}},
+			{Number: "81", Position: "9", PositionText: "9", Points: "2", Driver: Driver{DriverID: "piastri"}, Constructor: Constructor{ConstructorID: "mclaren"}, Grid: "2", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6146752", Time: "+20.448"}},
+			{Number: "44", Position: "10", PositionText: "10", Points: "1", Driver: Driver{DriverID: "hamilton"}, Constructor: Constructor{ConstructorID: "ferrari"}, Grid: "8", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6148777", Time: "+22.473"}},
+			{Number: "10", Position: "11", PositionText: "11", Points: "0", Driver: Driver{DriverID: "gasly"}, Constructor: Constructor{ConstructorID: "alpine"}, Grid: "9", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6152806", Time: "+26.502"}},
+			{Number: "22", Position: "12", PositionText: "12", Points: "0", Driver: Driver{DriverID: "tsunoda"}, Constructor: Constructor{ConstructorID: "rb"}, Grid: "5", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6156188", Time: "+29.884"}},
+			{Number: "31", Position: "13", PositionText: "13", Points: "0", Driver: Driver{DriverID: "ocon"}, Constructor: Constructor{ConstructorID: "haas"}, Grid: "19", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6159465", Time: "+33.161"}},
+			{Number: "87", Position: "14", PositionText: "14", Points: "0", Driver: Driver{DriverID: "bearman"}, Constructor: Constructor{ConstructorID: "haas"}, Grid: "20", Laps: "57", Status: "Finished", Time: &ResultTime{Millis: "6166655", Time: "+40.351"}},
+			{Number: "30", Position: "15", PositionText: "R", Points: "0", Driver: Driver{DriverID: "lawson"}, Constructor: Constructor{ConstructorID: "red_bull"}, Grid: "18", Laps: "46", Status: "Accident"},
+			{Number: "5", Position: "16", PositionText: "R", Points: "0", Driver: Driver{DriverID: "bortoleto"}, Constructor: Constructor{ConstructorID: "sauber"}, Grid: "15", Laps: "45", Status: "Accident"},
+			{Number: "14", Position: "17", PositionText: "R", Points: "0", Driver: Driver{DriverID: "alonso"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Grid: "12", Laps: "32", Status: "Accident"},
+			{Number: "55", Position: "18", PositionText: "R", Points: "0", Driver: Driver{DriverID: "sainz"}, Constructor: Constructor{ConstructorID: "williams"}, Grid: "10", Laps: "0", Status: "Accident"},
+			{Number: "7", Position: "19", PositionText: "R", Points: "0", Driver: Driver{DriverID: "doohan"}, Constructor: Constructor{ConstructorID: "alpine"}, Grid: "14", Laps: "0", Status: "Accident"},
+			{Number: "6", Position: "20", PositionText: "W", Points: "0", Driver: Driver{DriverID: "hadjar"}, Constructor: Constructor{ConstructorID: "rb"}, Grid: "11", Laps: "0", Status: "Did not start"},
+		},
+		Qualifying: []QualifyingResult{
+			{Number: "4", Position: "1", Driver: Driver{DriverID: "norris"}, Constructor: Constructor{ConstructorID: "mclaren"}, Q3: "1:15.096"},
+			{Number: "81", Position: "2", Driver: Driver{DriverID: "piastri"}, Constructor: Constructor{ConstructorID: "mclaren"}, Q3: "1:15.180"},
+			{Number: "1", Position: "3", Driver: Driver{DriverID: "max_verstappen"}, Constructor: Constructor{ConstructorID: "red_bull"}, Q3: "1:15.481"},
+			{Number: "63", Position: "4", Driver: Driver{DriverID: "russell"}, Constructor: Constructor{ConstructorID: "mercedes"}, Q3: "1:15.546"},
+			{Number: "22", Position: "5", Driver: Driver{DriverID: "tsunoda"}, Constructor: Constructor{ConstructorID: "rb"}, Q3: "1:15.670"},
+			{Number: "23", Position: "6", Driver: Driver{DriverID: "albon"}, Constructor: Constructor{ConstructorID: "williams"}, Q3: "1:15.737"},
+			{Number: "16", Position: "7", Driver: Driver{DriverID: "leclerc"}, Constructor: Constructor{ConstructorID: "ferrari"}, Q3: "1:15.755"},
+			{Number: "44", Position: "8", Driver: Driver{DriverID: "hamilton"}, Constructor: Constructor{ConstructorID: "ferrari"}, Q3: "1:15.973"},
+			{Number: "10", Position: "9", Driver: Driver{DriverID: "gasly"}, Constructor: Constructor{ConstructorID: "alpine"}, Q3: "1:15.980"},
+			{Number: "55", Position: "10", Driver: Driver{DriverID: "sainz"}, Constructor: Constructor{ConstructorID: "williams"}, Q3: "1:16.062"},
+			{Number: "6", Position: "11", Driver: Driver{DriverID: "hadjar"}, Constructor: Constructor{ConstructorID: "rb"}, Q2: "1:16.175"},
+			{Number: "14", Position: "12", Driver: Driver{DriverID: "alonso"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Q2: "1:16.288"},
+			{Number: "18", Position: "13", Driver: Driver{DriverID: "stroll"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Q2: "1:16.483"},
+			{Number: "7", Position: "14", Driver: Driver{DriverID: "doohan"}, Constructor: Constructor{ConstructorID: "alpine"}, Q2: "1:16.863"},
+			{Number: "5", Position: "15", Driver: Driver{DriverID: "bortoleto"}, Constructor: Constructor{ConstructorID: "sauber"}, Q2: "1:17.520"},
+			{Number: "12", Position: "16", Driver: Driver{DriverID: "antonelli"}, Constructor: Constructor{ConstructorID: "mercedes"}, Q1: "1:16.525"},
+			{Number: "27", Position: "17", Driver: Driver{DriverID: "hulkenberg"}, Constructor: Constructor{ConstructorID: "sauber"}, Q1: "1:16.579"},
+			{Number: "30", Position: "18", Driver: Driver{DriverID: "lawson"}, Constructor: Constructor{ConstructorID: "red_bull"}, Q1: "1:17.094"},
+			{Number: "31", Position: "19", Driver: Driver{DriverID: "ocon"}, Constructor: Constructor{ConstructorID: "haas"}, Q1: "1:17.147"},
+			{Number: "87", Position: "20", Driver: Driver{DriverID: "bearman"}, Constructor: Constructor{ConstructorID: "haas"}},
+		},
+	},
+	// Manche 2 : Chinese Grand Prix
+	"2": {
+		Race: []RaceResult{
+			{Number: "81", Position: "1", PositionText: "1", Points: "25", Driver: Driver{DriverID: "piastri"}, Constructor: Constructor{ConstructorID: "mclaren"}, Grid: "1", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5455026", Time: "1:30:55.026"}},
+			{Number: "4", Position: "2", PositionText: "2", Points: "18", Driver: Driver{DriverID: "norris"}, Constructor: Constructor{ConstructorID: "mclaren"}, Grid: "3", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5464774", Time: "+9.748"}, FastestLap: &FastestLap{Rank: "1", Lap: "53", Time: ResultTime{Time: "1:35.454"}}},
+			{Number: "63", Position: "3", PositionText: "3", Points: "15", Driver: Driver{DriverID: "russell"}, Constructor: Constructor{ConstructorID: "mercedes"}, Grid: "2", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5466123", Time: "+11.097"}},
+			{Number: "1", Position: "4", PositionText: "4", Points: "12", Driver: Driver{DriverID: "max_verstappen"}, Constructor: Constructor{ConstructorID: "red_bull"}, Grid: "4", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5471598", Time: "+16.572"}},
+			{Number: "31", Position: "5", PositionText: "5", Points: "10", Driver: Driver{DriverID: "ocon"}, Constructor: Constructor{ConstructorID: "haas"}, Grid: "11", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5504995", Time: "+49.969"}},
+			{Number: "12", Position: "6", PositionText: "6", Points: "8", Driver: Driver{DriverID: "antonelli"}, Constructor: Constructor{ConstructorID: "mercedes"}, Grid: "8", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5508774", Time: "+53.748"}},
+			{Number: "23", Position: "7", PositionText: "7", Points: "6", Driver: Driver{DriverID: "albon"}, Constructor: Constructor{ConstructorID: "williams"}, Grid: "10", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5511347", Time: "+56.321"}},
+			{Number: "87", Position: "8", PositionText: "8", Points: "4", Driver: Driver{DriverID: "bearman"}, Constructor: Constructor{ConstructorID: "haas"}, Grid: "17", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5516329", Time: "+1:01.303"}},
+			{Number: "18", Position: "9", PositionText: "9", Points: "2", Driver: Driver{DriverID: "stroll"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Grid: "14", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5525230", Time: "+1:10.204"}},
+			{Number: "55", Position: "10", PositionText: "10", Points: "1", Driver: Driver{DriverID: "sainz"}, Constructor: Constructor{ConstructorID: "williams"}, Grid: "15", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5531413", Time: "+1:16.387"}},
+			{Number: "6", Position: "11", PositionText: "11", Points: "0", Driver: Driver{DriverID: "hadjar"}, Constructor: Constructor{ConstructorID: "rb"}, Grid: "7", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5533901", Time: "+1:18.875"}},
+			{Number: "30", Position: "12", PositionText: "12", Points: "0", Driver: Driver{DriverID: "lawson"}, Constructor: Constructor{ConstructorID: "red_bull"}, Grid: "20", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5536173", Time: "+1:21.147"}},
+			{Number: "7", Position: "13", PositionText: "13", Points: "0", Driver: Driver{DriverID: "doohan"}, Constructor: Constructor{ConstructorID: "alpine"}, Grid: "18", Laps: "56", Status: "Finished", Time: &ResultTime{Millis: "5543427", Time: "+1:28.401"}},
+			{Number: "5", Position: "14", PositionText: "14", Points: "0", Driver: Driver{DriverID: "bortoleto"}, Constructor: Constructor{ConstructorID: "sauber"}, Grid: "19", Laps: "55", Status: "+1 Lap"},
+			{Number: "27", Position: "15", PositionText: "15", Points: "0", Driver: Driver{DriverID: "hulkenberg"}, Constructor: Constructor{ConstructorID: "sauber"}, Grid: "12", Laps: "55", Status: "+1 Lap"},
+			{Number: "22", Position: "16", PositionText: "16", Points: "0", Driver: Driver{DriverID: "tsunoda"}, Constructor: Constructor{ConstructorID: "rb"}, Grid: "9", Laps: "55", Status: "+1 Lap"},
+			{Number: "14", Position: "17", PositionText: "R", Points: "0", Driver: Driver{DriverID: "alonso"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Grid: "13", Laps: "4", Status: "Brakes"},
+			{Number: "16", Position: "18", PositionText: "D", Points: "0", Driver: Driver{DriverID: "leclerc"}, Constructor: Constructor{ConstructorID: "ferrari"}, Grid: "6", Laps: "56", Status: "Disqualified"},
+			{Number: "44", Position: "19", PositionText: "D", Points: "0", Driver: Driver{DriverID: "hamilton"}, Constructor: Constructor{ConstructorID: "ferrari"}, Grid: "5", Laps: "56", Status: "Disqualified"},
+			{Number: "10", Position: "20", PositionText: "D", Points: "0", Driver: Driver{DriverID: "gasly"}, Constructor: Constructor{ConstructorID: "alpine"}, Grid: "16", Laps: "56", Status: "Disqualified"},
+		},
+		Sprint: []RaceResult{
+			{Number: "44", Position: "1", PositionText: "1", Points: "8", Driver: Driver{DriverID: "hamilton"}, Constructor: Constructor{ConstructorID: "ferrari"}, Grid: "1", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1839965", Time: "30:39.965"}},
+			{Number: "81", Position: "2", PositionText: "2", Points: "7", Driver: Driver{DriverID: "piastri"}, Constructor: Constructor{ConstructorID: "mclaren"}, Grid: "3", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1846854", Time: "+6.889"}},
+			{Number: "1", Position: "3", PositionText: "3", Points: "6", Driver: Driver{DriverID: "max_verstappen"}, Constructor: Constructor{ConstructorID: "red_bull"}, Grid: "2", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1849769", Time: "+9.804"}},
+			{Number: "63", Position: "4", PositionText: "4", Points: "5", Driver: Driver{DriverID: "russell"}, Constructor: Constructor{ConstructorID: "mercedes"}, Grid: "4", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1851557", Time: "+11.592"}},
+			{Number: "16", Position: "5", PositionText: "5", Points: "4", Driver: Driver{DriverID: "leclerc"}, Constructor: Constructor{ConstructorID: "ferrari"}, Grid: "5", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1852155", Time: "+12.190"}},
+			{Number: "22", Position: "6", PositionText: "6", Points: "3", Driver: Driver{DriverID: "tsunoda"}, Constructor: Constructor{ConstructorID: "rb"}, Grid: "8", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1862253", Time: "+22.288"}},
+			{Number: "12", Position: "7", PositionText: "7", Points: "2", Driver: Driver{DriverID: "antonelli"}, Constructor: Constructor{ConstructorID: "mercedes"}, Grid: "7", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1863003", Time: "+23.038"}},
+			{Number: "4", Position: "8", PositionText: "8", Points: "1", Driver: Driver{DriverID: "norris"}, Constructor: Constructor{ConstructorID: "mclaren"}, Grid: "6", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1863436", Time: "+23.471"}},
+			{Number: "18", Position: "9", PositionText: "9", Points: "0", Driver: Driver{DriverID: "stroll"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Grid: "9", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1864881", Time: "+24.916"}},
+			{Number: "14", Position: "10", PositionText: "10", Points: "0", Driver: Driver{DriverID: "alonso"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Grid: "10", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1878183", Time: "+38.218"}},
+			{Number: "55", Position: "11", PositionText: "11", Points: "0", Driver: Driver{DriverID: "sainz"}, Constructor: Constructor{ConstructorID: "williams"}, Grid: "16", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1879257", Time: "+39.292"}},
+			{Number: "6", Position: "12", PositionText: "12", Points: "0", Driver: Driver{DriverID: "hadjar"}, Constructor: Constructor{ConstructorID: "rb"}, Grid: "13", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1879614", Time: "+39.649"}},
+			{Number: "30", Position: "13", PositionText: "13", Points: "0", Driver: Driver{DriverID: "lawson"}, Constructor: Constructor{ConstructorID: "red_bull"}, Grid: "14", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1882365", Time: "+42.400"}},
+			{Number: "87", Position: "14", PositionText: "14", Points: "0", Driver: Driver{DriverID: "bearman"}, Constructor: Constructor{ConstructorID: "haas"}, Grid: "20", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1882963", Time: "+42.998"}},
+			{Number: "31", Position: "15", PositionText: "15", Points: "0", Driver: Driver{DriverID: "ocon"}, Constructor: Constructor{ConstructorID: "haas"}, Grid: "18", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1884808", Time: "+44.843"}},
+			{Number: "10", Position: "16", PositionText: "16", Points: "0", Driver: Driver{DriverID: "gasly"}, Constructor: Constructor{ConstructorID: "alpine"}, Grid: "12", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1885685", Time: "+45.720"}},
+			{Number: "23", Position: "17", PositionText: "17", Points: "0", Driver: Driver{DriverID: "albon"}, Constructor: Constructor{ConstructorID: "williams"}, Grid: "11", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1886370", Time: "+46.405"}},
+			{Number: "27", Position: "18", PositionText: "18", Points: "0", Driver: Driver{DriverID: "hulkenberg"}, Constructor: Constructor{ConstructorID: "sauber"}, Grid: "15", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1886615", Time: "+46.650"}},
+			{Number: "5", Position: "19", PositionText: "19", Points: "0", Driver: Driver{DriverID: "bortoleto"}, Constructor: Constructor{ConstructorID: "sauber"}, Grid: "17", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1889290", Time: "+49.325"}},
+			{Number: "7", Position: "20", PositionText: "20", Points: "0", Driver: Driver{DriverID: "doohan"}, Constructor: Constructor{ConstructorID: "alpine"}, Grid: "19", Laps: "19", Status: "Finished", Time: &ResultTime{Millis: "1890235", Time: "+50.270"}},
+		},
+		Qualifying: []QualifyingResult{
+			{Number: "81", Position: "1", Driver: Driver{DriverID: "piastri"}, Constructor: Constructor{ConstructorID: "mclaren"}, Q3: "1:30.641"},
+			{Number: "63", Position: "2", Driver: Driver{DriverID: "russell"}, Constructor: Constructor{ConstructorID: "mercedes"}, Q3: "1:30.723"},
+			{Number: "4", Position: "3", Driver: Driver{DriverID: "norris"}, Constructor: Constructor{ConstructorID: "mclaren"}, Q3: "1:30.793"},
+			{Number: "1", Position: "4", Driver: Driver{DriverID: "max_verstappen"}, Constructor: Constructor{ConstructorID: "red_bull"}, Q3: "1:30.817"},
+			{Number: "44", Position: "5", Driver: Driver{DriverID: "hamilton"}, Constructor: Constructor{ConstructorID: "ferrari"}, Q3: "1:30.927"},
+			{Number: "16", Position: "6", Driver: Driver{DriverID: "leclerc"}, Constructor: Constructor{ConstructorID: "ferrari"}, Q3: "1:31.021"},
+			{Number: "6", Position: "7", Driver: Driver{DriverID: "hadjar"}, Constructor: Constructor{ConstructorID: "rb"}, Q3: "1:31.079"},
+			{Number: "12", Position: "8", Driver: Driver{DriverID: "antonelli"}, Constructor: Constructor{ConstructorID: "mercedes"}, Q3: "1:31.103"},
+			{Number: "22", Position: "9", Driver: Driver{DriverID: "tsunoda"}, Constructor: Constructor{ConstructorID: "rb"}, Q3: "1:31.638"},
+			{Number: "23", Position: "10", Driver: Driver{DriverID: "albon"}, Constructor: Constructor{ConstructorID: "williams"}, Q3: "1:31.706"},
+			{Number: "31", Position: "11", Driver: Driver{DriverID: "ocon"}, Constructor: Constructor{ConstructorID: "haas"}, Q2: "1:31.625"},
+			{Number: "27", Position: "12", Driver: Driver{DriverID: "hulkenberg"}, Constructor: Constructor{ConstructorID: "sauber"}, Q2: "1:31.632"},
+			{Number: "14", Position: "13", Driver: Driver{DriverID: "alonso"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Q2: "1:31.688"},
+			{Number: "18", Position: "14", Driver: Driver{DriverID: "stroll"}, Constructor: Constructor{ConstructorID: "aston_martin"}, Q2: "1:31.773"},
+			{Number: "55", Position: "15", Driver: Driver{DriverID: "sainz"}, Constructor: Constructor{ConstructorID: "williams"}, Q2: "1:31.840"},
+			{Number: "10", Position: "16", Driver: Driver{DriverID: "gasly"}, Constructor: Constructor{ConstructorID: "alpine"}, Q1: "1:31.992"},
+			{Number: "87", Position: "17", Driver: Driver{DriverID: "bearman"}, Constructor: Constructor{ConstructorID: "haas"}, Q1: "1:32.018"},
+			{Number: "7", Position: "18", Driver: Driver{DriverID: "doohan"}, Constructor: Constructor{ConstructorID: "alpine"}, Q1: "1:32.092"},
+			{Number: "5", Position: "19", Driver: Driver{DriverID: "bortoleto"}, Constructor: Constructor{ConstructorID: "sauber"}, Q1: "1:32.141"},
+			{Number: "30", Position: "20", Driver: Driver{DriverID: "lawson"}, Constructor: Constructor{ConstructorID: "red_bull"}, Q1: "1:32.174"},
+		},
+	},
+}
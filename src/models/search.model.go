@@ -0,0 +1,33 @@
+package models
+
+// HighlightSegment
+// Morceau du texte d'un champ affiché dans les résultats de recherche (Match : partie correspondant à la requête).
+type HighlightSegment struct {
+	Text  string
+	Match bool
+}
+
+// DriverHit
+// Pilote trouvé par la recherche, avec son score de pertinence et ses champs découpés pour le surlignage
+// (clés : givenName, familyName, code, permanentNumber, team, nationality, dateOfBirth).
+type DriverHit struct {
+	Driver     Driver
+	Score      int
+	Highlights map[string][]HighlightSegment
+}
+
+// ConstructorHit
+// Écurie trouvée par la recherche, avec son score de pertinence et ses champs découpés pour le surlignage
+// (clés : name, nationality).
+type ConstructorHit struct {
+	Constructor Constructor
+	Score       int
+	Highlights  map[string][]HighlightSegment
+}
+
+// SearchResults
+// Résultats d'une recherche, triés par pertinence décroissante.
+type SearchResults struct {
+	Drivers      []DriverHit
+	Constructors []ConstructorHit
+}
@@ -0,0 +1,17 @@
+package models
+
+// SeasonData
+// Structure regroupant les pilotes, écuries, le calendrier et les résultats (par manche) intégrés pour une saison.
+type SeasonData struct {
+	Drivers      []Driver
+	Constructors []Constructor
+	Races        []Race
+	Results      map[string]RoundResults
+}
+
+// SeasonsData
+// Données intégrées de chaque saison disponible, indexées par année.
+var SeasonsData = map[string]SeasonData{
+	"2025": {Drivers: DriversData, Constructors: ConstructorsData, Races: Races2025Data, Results: Results2025Data},
+	"2024": {Drivers: Drivers2024Data, Constructors: Constructors2024Data, Races: Races2024Data},
+}
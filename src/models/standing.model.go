@@ -0,0 +1,21 @@
+package models
+
+// DriverStanding
+// Structure représentant la place d'un pilote au championnat (points, victoires, écart avec le leader).
+type DriverStanding struct {
+	Position int
+	Driver   Driver
+	Points   float64
+	Wins     int
+	Gap      float64
+}
+
+// ConstructorStanding
+// Structure représentant la place d'une écurie au championnat constructeurs.
+type ConstructorStanding struct {
+	Position    int
+	Constructor Constructor
+	Points      float64
+	Wins        int
+	Gap         float64
+}
@@ -0,0 +1,15 @@
+package routers
+
+import (
+	"f1-app/controllers"
+	"net/http"
+)
+
+// accountsRouter
+// Enregistre les routes des comptes : page du compte, inscription, connexion et déconnexion.
+func accountsRouter(router *http.ServeMux) {
+	handle(router, "/account", controllers.AccountHandler)
+	handle(router, "/register", controllers.RegisterHandler)
+	handle(router, "/login", controllers.LoginHandler)
+	handle(router, "/logout", controllers.LogoutHandler)
+}
@@ -0,0 +1,27 @@
+package routers
+
+import (
+	"f1-app/controllers"
+	"net/http"
+)
+
+// apiRouter
+// ---------
+// Objectif :
+//   - Enregistrer les routes de l'API JSON versionnée sous /api/v1 et l'autocomplétion de la recherche.
+//   - Répondre en JSON (et non par une redirection vers /error) pour toute route inconnue sous /api/.
+func apiRouter(router *http.ServeMux) {
+	// Étape 1 : Enregistrer les listes.
+	handle(router, "/api/v1/drivers", controllers.APIDriversHandler)
+	handle(router, "/api/v1/constructors", controllers.APIConstructorsHandler)
+	handle(router, "/api/v1/search", controllers.APISearchHandler)
+	handle(router, "/api/v1/favorites", controllers.APIFavoritesHandler)
+	handle(router, "/api/search/suggest", controllers.APISuggestHandler)
+
+	// Étape 2 : Enregistrer les détails avec paramètres dynamiques.
+	handle(router, "/api/v1/drivers/", controllers.APIDriverHandler)
+	handle(router, "/api/v1/constructors/", controllers.APIConstructorHandler)
+
+	// Étape 3 : Enregistrer la route par défaut de l'API.
+	handle(router, "/api/", controllers.APINotFoundHandler)
+}
@@ -0,0 +1,36 @@
+package routers
+
+import (
+	"f1-app/controllers"
+	"f1-app/models"
+	"log"
+	"net/http"
+)
+
+// registeredRoutes recense, dans l'ordre d'enregistrement, les opérations documentées des routes.
+var registeredRoutes []models.RouteDoc
+
+// handle
+// Enregistre un handler et ses opérations documentées (routeDocs) ; une route absente de routeDocs arrête le démarrage.
+func handle(router *http.ServeMux, pattern string, handler http.HandlerFunc) {
+	docs, ok := routeDocs[pattern]
+	if !ok {
+		log.Fatalf("Route %s absente de la documentation OpenAPI (routers/routes.router.go)", pattern)
+	}
+	router.HandleFunc(pattern, handler)
+	registeredRoutes = append(registeredRoutes, docs...)
+}
+
+// docsRouter
+// ----------
+// Objectif :
+//   - Enregistrer la documentation interactive de l'API (/api/docs).
+//   - Servir la spécification OpenAPI 3 en JSON et en YAML.
+func docsRouter(router *http.ServeMux) {
+	// Étape 1 : Enregistrer la page de documentation.
+	handle(router, "/api/docs", controllers.APIDocsHandler)
+
+	// Étape 2 : Enregistrer la spécification dans ses deux formats.
+	handle(router, "/api/docs/openapi.json", controllers.OpenAPIJSONHandler)
+	handle(router, "/api/docs/openapi.yaml", controllers.OpenAPIYAMLHandler)
+}
@@ -0,0 +1,12 @@
+package routers
+
+import (
+	"f1-app/controllers"
+	"net/http"
+)
+
+// ergastRouter
+// Enregistre le miroir compatible Ergast (/ergast/f1/{season}/drivers|constructors[.json|.xml]).
+func ergastRouter(router *http.ServeMux) {
+	handle(router, "/ergast/f1/", controllers.ErgastHandler)
+}
@@ -12,5 +12,5 @@ import (
 //   - Afficher la page d'erreur avec codes d'erreur personnalisés.
 func errorRouter(router *http.ServeMux) {
 	// Étape 1 : Enregistrer la route /error pour afficher les pages d'erreur.
-	router.HandleFunc("/error", controllers.ErrorDisplay)
+	handle(router, "/error", controllers.ErrorDisplay)
 }
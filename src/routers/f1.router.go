@@ -2,32 +2,77 @@ package routers
 
 import (
 	"f1-app/controllers"
+	"f1-app/helpers"
 	"net/http"
+	"strings"
 )
 
 // f1Router
 // -----------
 // Objectif :
-//   - Enregistrer toutes les routes F1 (pilotes, écuries, recherche, favoris).
+//   - Enregistrer toutes les routes F1 (pilotes, écuries, calendrier, recherche, favoris).
 //   - Configurer les handlers pour les pages principales de l'application.
 func f1Router(router *http.ServeMux) {
-	// Étape 1 : Enregistrer la route racine vers l'index.
-	router.HandleFunc("/", controllers.IndexHandler)
+	// Étape 1 : Enregistrer la route racine (index et détails préfixés par une saison).
+	handle(router, "/", rootHandler)
 
 	// Étape 2 : Enregistrer les routes de navigation principales.
-	router.HandleFunc("/drivers", controllers.DriversHandler)
-	router.HandleFunc("/teams", controllers.TeamsHandler)
-	router.HandleFunc("/search", controllers.SearchHandler)
+	handle(router, "/drivers", controllers.DriversHandler)
+	handle(router, "/teams", controllers.TeamsHandler)
+	handle(router, "/races", controllers.RacesHandler)
+	handle(router, "/search", controllers.SearchHandler)
 
 	// Étape 3 : Enregistrer les routes de détail avec paramètres dynamiques.
-	router.HandleFunc("/teams/", controllers.TeamDetailHandler)
-	router.HandleFunc("/drivers/", controllers.DriverDetailHandler)
+	handle(router, "/teams/", controllers.TeamDetailHandler)
+	handle(router, "/drivers/", controllers.DriverDetailHandler)
+	handle(router, "/races/", controllers.RaceDetailHandler)
 
-	// Étape 4 : Enregistrer les routes de gestion des favoris.
-	router.HandleFunc("/favorites", controllers.FavoritesHandler)
-	router.HandleFunc("/add-favorite", controllers.AddFavoriteHandler)
-	router.HandleFunc("/remove-favorite", controllers.RemoveFavoriteHandler)
+	// Étape 4 : Enregistrer les routes de gestion des favoris, de leurs collections, de l'export et du partage.
+	handle(router, "/favorites", controllers.FavoritesHandler)
+	handle(router, "/add-favorite", controllers.AddFavoriteHandler)
+	handle(router, "/remove-favorite", controllers.RemoveFavoriteHandler)
+	handle(router, "/favorites/collections/create", controllers.CreateCollectionHandler)
+	handle(router, "/favorites/collections/rename", controllers.RenameCollectionHandler)
+	handle(router, "/favorites/collections/delete", controllers.DeleteCollectionHandler)
+	handle(router, "/favorites/items/move", controllers.MoveFavoriteHandler)
+	handle(router, "/favorites/items/edit", controllers.EditFavoriteHandler)
+	handle(router, "/favorites/orphans/remove", controllers.RemoveOrphanedFavoritesHandler)
+	handle(router, "/favorites/history", controllers.FavoritesHistoryHandler)
+	handle(router, "/favorites/history/rebuild", controllers.RebuildFavoritesHandler)
+	handle(router, "/favorites/undo", controllers.UndoFavoriteHandler)
+	handle(router, "/favorites/export", controllers.ExportFavoritesHandler)
+	handle(router, "/favorites/import", controllers.ImportFavoritesHandler)
+	handle(router, "/favorites/share", controllers.ShareFavoritesHandler)
+	handle(router, "/favorites/unshare", controllers.UnshareFavoritesHandler)
+	handle(router, "/shared/", controllers.SharedFavoritesHandler)
 
 	// Étape 5 : Enregistrer la route supplémentaire.
-	router.HandleFunc("/about", controllers.AboutHandler)
+	handle(router, "/about", controllers.AboutHandler)
+}
+
+// rootHandler
+// -----------
+// Objectif :
+//   - Aiguiller les URL /{season}/drivers/{id}, /{season}/teams/{id} et /{season}/races/{round} vers les handlers de détail.
+//   - Laisser l'index gérer toutes les autres URL (page d'accueil ou 404).
+func rootHandler(w http.ResponseWriter, r *http.Request) {
+	// Étape 1 : Découper le chemin et vérifier la présence d'une saison en préfixe.
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) == 3 && helpers.IsSeasonSegment(parts[0]) {
+		// Étape 2 : Déléguer au handler de détail correspondant à la section.
+		switch parts[1] {
+		case "drivers":
+			controllers.DriverDetailHandler(w, r)
+			return
+		case "teams":
+			controllers.TeamDetailHandler(w, r)
+			return
+		case "races":
+			controllers.RaceDetailHandler(w, r)
+			return
+		}
+	}
+
+	// Étape 3 : Sinon, afficher l'index (qui renvoie une 404 pour les chemins inconnus).
+	controllers.IndexHandler(w, r)
 }
@@ -0,0 +1,12 @@
+package routers
+
+import (
+	"f1-app/controllers"
+	"net/http"
+)
+
+// graphqlRouter
+// Enregistre l'endpoint GraphQL (/graphql) sur les pilotes, écuries et favoris.
+func graphqlRouter(router *http.ServeMux) {
+	handle(router, "/graphql", controllers.GraphQLHandler)
+}
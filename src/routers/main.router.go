@@ -1,6 +1,8 @@
 package routers
 
 import (
+	"f1-app/controllers"
+	"f1-app/services"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -10,19 +12,29 @@ import (
 // ----------
 // Objectif :
 //   - Initialiser et configurer le routeur principal de l'application.
-//   - Enregistrer toutes les routes métier (erreurs, F1).
+//   - Enregistrer toutes les routes métier (erreurs, F1, comptes, API JSON, miroir Ergast, GraphQL, documentation).
+//   - Transmettre les routes documentées au service OpenAPI.
 //   - Configurer le serveur de fichiers statiques pour CSS, JS, images et audio.
+//   - Identifier le visiteur de chaque requête (session ou cookie anonyme) avant le routage.
+//   - Protéger les actions POST contre les requêtes d'autres sites (jeton CSRF) et les redirections ouvertes.
 //   - Retourner le routeur configuré prêt à être utilisé.
-func MainRouter() *http.ServeMux {
+func MainRouter() http.Handler {
 
 	// Étape 1 : Créer le routeur principal avec http.ServeMux.
 	mainRouter := http.NewServeMux()
+	registeredRoutes = nil
 
 	// Étape 2 : Enregistrer les routes de gestion des erreurs.
 	errorRouter(mainRouter)
 
-	// Étape 3 : Enregistrer les routes métier de Formule 1.
+	// Étape 3 : Enregistrer les routes métier de Formule 1, les comptes, l'API JSON, le miroir Ergast, GraphQL et la documentation.
 	f1Router(mainRouter)
+	accountsRouter(mainRouter)
+	apiRouter(mainRouter)
+	ergastRouter(mainRouter)
+	graphqlRouter(mainRouter)
+	docsRouter(mainRouter)
+	services.UseRouteDocs(registeredRoutes)
 
 	// Étape 4 : Déterminer le chemin du répertoire des assets.
 	wd, _ := os.Getwd()
@@ -39,6 +51,6 @@ func MainRouter() *http.ServeMux {
 	// Étape 6 : Enregistrer la route /static/ pour servir les fichiers statiques.
 	mainRouter.Handle("/static/", http.StripPrefix("/static/", fileServer))
 
-	// Étape 7 : Retourner le routeur configuré.
-	return mainRouter
+	// Étape 7 : Retourner le routeur configuré, précédé de la vérification CSRF et de l'identification du visiteur.
+	return controllers.CSRFMiddleware(controllers.SessionMiddleware(mainRouter))
 }
@@ -0,0 +1,515 @@
+package routers
+
+import (
+	"f1-app/models"
+	"f1-app/services"
+	"net/http"
+	"strconv"
+)
+
+// Paramètres communs à plusieurs opérations.
+var (
+	seasonParam = models.ParamDoc{
+		Name: "season", In: "query", Type: "string", Default: services.DefaultSeason,
+		Description: "Season year (unknown seasons return 404).",
+	}
+	seasonPathParam = models.ParamDoc{
+		Name: "season", In: "path", Type: "string",
+		Description: "Season year (4 digits).",
+	}
+	constructorSortParam = models.ParamDoc{
+		Name: "sort", In: "query", Type: "string", Enum: services.ConstructorSortKeys(), Default: services.SortStandings,
+		Description: "Championship order, roster order, name or number of drivers (drivers).",
+	}
+	driverSortParam = models.ParamDoc{
+		Name: "sort", In: "query", Type: "string", Enum: services.DriverSortKeys(), Default: services.SortStandings,
+		Description: "Championship order, roster order, surname, car number, age, team, code or date of birth (dob).",
+	}
+	orderParam = models.ParamDoc{
+		Name: "order", In: "query", Type: "string", Enum: []string{services.OrderAsc, services.OrderDesc}, Default: services.OrderAsc,
+		Description: "Sort direction (age ascending: youngest first). Items without a value for the sort key (e.g. unclassified) always come last.",
+	}
+	pageParam = models.ParamDoc{
+		Name: "page", In: "query", Type: "integer", Default: "1",
+		Description: "Page number (out of range values are clamped).",
+	}
+	perPageParam = models.ParamDoc{
+		Name: "perPage", In: "query", Type: "integer",
+		Description: "Items per page, among the sizes allowed by the server (10, 20 or 30 unless F1_PAGE_SIZES is set; the first size is the default). Other sizes return 400.",
+	}
+	historyPerPageParam = models.ParamDoc{
+		Name: "perPage", In: "query", Type: "integer",
+		Description: "Items per page, among the sizes allowed by the server (other sizes fall back to the default).",
+	}
+	cursorParam = models.ParamDoc{
+		Name: "cursor", In: "query", Type: "string",
+		Description: "Opaque cursor from pagination.nextCursor, pagination.prevCursor or the Link header; replaces page and stays stable when items are added elsewhere in the list. " +
+			"A cursor made for another season, sort or filter set, or whose item is gone, returns 400.",
+	}
+	paginationHeaders = []models.ParamDoc{
+		{Name: "Link", Type: "string", Description: "Links to the first, prev, next and last pages (RFC 8288); prev and next use cursors."},
+		{Name: "X-Total-Count", Type: "integer", Description: "Number of items after filtering."},
+	}
+	searchQueryParam = models.ParamDoc{
+		Name: "q", In: "query", Type: "string", Required: true,
+		Description: "Search query: free text (case-insensitive, \"quoted phrases\"), field filters (team:ferrari, nationality:british, type:\"test driver\", name:, code:, id:), " +
+			"comparisons on number and born (number:44, number:<10, born:<2000, born:>=1997-06), negation (-team:haas), OR and parentheses. " +
+			"Terms separated by spaces must all match. Accents and small typos are ignored; results are sorted by relevance (exact code, number or surname first). " +
+			"Syntax errors return 400 with the position of the error.",
+	}
+	driverFilterParams = []models.ParamDoc{
+		{Name: "team", In: "query", Type: "string", Repeated: true, Description: "Exact team name; repeat to keep drivers of any of the given teams."},
+		{Name: "nationality", In: "query", Type: "string", Repeated: true, Description: "Exact nationality; repeat to keep any of the given nationalities."},
+		{Name: "driverType", In: "query", Type: "string", Repeated: true, Description: "Driver type (e.g. Race Driver, Test Driver); repeat to keep any of the given types."},
+		{Name: "decade", In: "query", Type: "string", Repeated: true, Description: "Birth decade (e.g. 1990s, 400 if malformed); repeat to keep any of the given decades."},
+		{Name: "rookie", In: "query", Type: "string", Repeated: true, Enum: []string{services.RookieYes, services.RookieNo}, Description: "true: drivers absent from the previous season; false: drivers who took part in it (400 for any other value or if the previous season is unavailable)."},
+		{Name: "ageMin", In: "query", Type: "integer", Description: "Minimum age (at the end of a past season, today otherwise)."},
+		{Name: "ageMax", In: "query", Type: "integer", Description: "Maximum age."},
+	}
+	constructorFilterParams = []models.ParamDoc{
+		{Name: "nationality", In: "query", Type: "string", Repeated: true, Description: "Exact nationality; repeat to keep any of the given nationalities."},
+		{Name: "engine", In: "query", Type: "string", Repeated: true, Description: "Exact engine supplier (e.g. Mercedes, Honda RBPT); repeat to keep any of the given suppliers."},
+	}
+	favoriteForm = []models.ParamDoc{
+		{Name: "type", Type: "string", Required: true, Enum: []string{"driver", "constructor"}},
+		{Name: "id", Type: "string", Required: true, Description: "Driver or constructor identifier."},
+		{Name: "returnUrl", Type: "string", Description: "Page to redirect to afterwards."},
+	}
+	returnURLField          = models.ParamDoc{Name: "returnUrl", Type: "string", Description: "Page to redirect to afterwards."}
+	optionalCollectionField = models.ParamDoc{Name: "collection", Type: "string", Description: "Collection identifier (defaults to the default collection)."}
+	collectionField         = models.ParamDoc{Name: "collection", Type: "string", Required: true, Description: "Collection identifier."}
+	collectionNameField     = models.ParamDoc{Name: "name", Type: "string", Required: true, Description: "Collection name (1 to 60 characters, unique per visitor, case-insensitive)."}
+	favoritesGroupParam     = models.ParamDoc{
+		Name: "group", In: "query", Type: "string", Enum: []string{services.FavoritesGroupCollection, services.FavoritesGroupType, services.FavoritesGroupTag},
+		Default: services.FavoritesGroupCollection, Description: "Group the favorites by collection, by type (drivers, teams) or by tag.",
+	}
+	favoritesSortParam = models.ParamDoc{
+		Name: "sort", In: "query", Type: "string", Enum: []string{services.FavoritesSortPosition, services.FavoritesSortAdded, services.FavoritesSortName},
+		Default: services.FavoritesSortPosition, Description: "Order chosen by the visitor, most recently added first, or by name.",
+	}
+	credentialsForm = []models.ParamDoc{
+		{Name: "username", Type: "string", Required: true, Description: "3 to 32 characters: letters, digits, '.', '_' or '-' (case-insensitive)."},
+		{Name: "password", Type: "string", Required: true, Description: "8 to 72 characters."},
+	}
+	ergastParams = []models.ParamDoc{
+		{Name: "format", In: "query", Type: "string", Enum: []string{"json", "xml"}, Default: "json", Description: "Response format (a .json or .xml suffix on the path does the same)."},
+		{Name: "limit", In: "query", Type: "integer", Default: "30", Description: "Maximum number of items (capped at 100)."},
+		{Name: "offset", In: "query", Type: "integer", Default: "0", Description: "Number of items to skip."},
+	}
+)
+
+// Formats de réponse d'une page HTML rendue côté serveur et de l'API JSON.
+var (
+	htmlPage = []string{"text/html"}
+	jsonBody = []string{"application/json"}
+)
+
+// params
+// Concatène des listes de paramètres documentés.
+func params(groups ...[]models.ParamDoc) []models.ParamDoc {
+	all := []models.ParamDoc{}
+	for _, group := range groups {
+		all = append(all, group...)
+	}
+	return all
+}
+
+// pathParam
+// Construit un paramètre de chemin documenté.
+func pathParam(name, description string) models.ParamDoc {
+	return models.ParamDoc{Name: name, In: "path", Type: "string", Description: description}
+}
+
+// routeDocs
+// ---------
+// Objectif :
+//   - Décrire, pour chaque pattern enregistré via handle, les opérations exposées (source de la spécification OpenAPI).
+//   - Un pattern peut couvrir plusieurs chemins (ex. "/" aiguille vers les détails préfixés par une saison).
+//   - Une liste vide marque une route volontairement non documentée (route par défaut de l'API).
+var routeDocs = map[string][]models.RouteDoc{
+	// Routes de gestion des erreurs.
+	"/error": {{
+		Method: http.MethodGet, Path: "/error", OperationID: "getErrorPage", Tag: "pages",
+		Summary:      "Error page",
+		Description:  "Target of every HTML error redirect.",
+		Params:       []models.ParamDoc{{Name: "code", In: "query", Type: "integer"}, {Name: "message", In: "query", Type: "string"}},
+		ContentTypes: htmlPage,
+	}},
+
+	// Pages HTML (f1Router).
+	"/": {
+		{
+			Method: http.MethodGet, Path: "/", OperationID: "getHomePage", Tag: "pages",
+			Summary:      "Home page",
+			Description:  "Season overview with the next race. Any other unknown path returns the 404 page.",
+			Params:       []models.ParamDoc{seasonParam},
+			ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+		},
+		{
+			Method: http.MethodGet, Path: "/{season}/drivers/{id}", OperationID: "getSeasonDriverPage", Tag: "pages",
+			Summary:      "Driver page of a season",
+			Params:       []models.ParamDoc{seasonPathParam, pathParam("id", "Driver identifier.")},
+			ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+		},
+		{
+			Method: http.MethodGet, Path: "/{season}/teams/{id}", OperationID: "getSeasonTeamPage", Tag: "pages",
+			Summary:      "Team page of a season",
+			Params:       []models.ParamDoc{seasonPathParam, pathParam("id", "Constructor identifier.")},
+			ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+		},
+		{
+			Method: http.MethodGet, Path: "/{season}/races/{round}", OperationID: "getSeasonRacePage", Tag: "pages",
+			Summary:      "Race page of a season",
+			Description:  "Race details with race, sprint and qualifying results.",
+			Params:       []models.ParamDoc{seasonPathParam, pathParam("round", "Round number.")},
+			ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+		},
+	},
+	"/drivers": {{
+		Method: http.MethodGet, Path: "/drivers", OperationID: "getDriversPage", Tag: "pages",
+		Summary:      "Drivers list",
+		Description:  "Filterable and paginated driver list with championship standings. Filters are shown as checkbox facets with the number of matching drivers.",
+		Params:       params([]models.ParamDoc{seasonParam}, driverFilterParams, []models.ParamDoc{driverSortParam, orderParam, pageParam, perPageParam, cursorParam}),
+		ContentTypes: htmlPage, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/teams": {{
+		Method: http.MethodGet, Path: "/teams", OperationID: "getTeamsPage", Tag: "pages",
+		Summary:      "Teams list",
+		Description:  "Filtered and paginated constructors with their championship standings.",
+		Params:       params([]models.ParamDoc{seasonParam}, constructorFilterParams, []models.ParamDoc{constructorSortParam, orderParam, pageParam, perPageParam, cursorParam}),
+		ContentTypes: htmlPage, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/races": {{
+		Method: http.MethodGet, Path: "/races", OperationID: "getRacesPage", Tag: "pages",
+		Summary:      "Race calendar",
+		Params:       []models.ParamDoc{seasonParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/search": {{
+		Method: http.MethodGet, Path: "/search", OperationID: "getSearchPage", Tag: "pages",
+		Summary:      "Search drivers and teams",
+		Params:       []models.ParamDoc{searchQueryParam, seasonParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed},
+	}},
+	"/teams/": {{
+		Method: http.MethodGet, Path: "/teams/{id}", OperationID: "getTeamPage", Tag: "pages",
+		Summary:      "Team page",
+		Params:       []models.ParamDoc{pathParam("id", "Constructor identifier."), seasonParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+	}},
+	"/drivers/": {{
+		Method: http.MethodGet, Path: "/drivers/{id}", OperationID: "getDriverPage", Tag: "pages",
+		Summary:      "Driver page",
+		Params:       []models.ParamDoc{pathParam("id", "Driver identifier."), seasonParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+	}},
+	"/races/": {{
+		Method: http.MethodGet, Path: "/races/{round}", OperationID: "getRacePage", Tag: "pages",
+		Summary:      "Race page",
+		Params:       []models.ParamDoc{pathParam("round", "Round number."), seasonParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+	}},
+	"/favorites": {{
+		Method: http.MethodGet, Path: "/favorites", OperationID: "getFavoritesPage", Tag: "favorites",
+		Summary:      "Favorites page",
+		Description:  "Collections of the visitor with the notes, tags and dates of their items. Items can be reordered (drag and drop, or up and down buttons) when grouped by collection in the visitor's order. Items that exist in no season are kept and shown as no longer on the grid.",
+		Params:       []models.ParamDoc{seasonParam, favoritesGroupParam, favoritesSortParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/add-favorite": {{
+		Method: http.MethodPost, Path: "/add-favorite", OperationID: "addFavorite", Tag: "favorites",
+		Summary:     "Add a driver or team to the favorites",
+		Description: "Favorites belong to the signed-in account, or to the browser (f1_visitor cookie) for anonymous visitors. Without a collection, the item goes to the default collection. The change is recorded in the favorites history.",
+		Form:        params(favoriteForm, []models.ParamDoc{optionalCollectionField}), Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/remove-favorite": {{
+		Method: http.MethodPost, Path: "/remove-favorite", OperationID: "removeFavorite", Tag: "favorites",
+		Summary:     "Remove a driver or team from the favorites",
+		Description: "Without a collection, the item is removed from every collection. The change is recorded in the favorites history and can be undone.",
+		Form:        params(favoriteForm, []models.ParamDoc{optionalCollectionField}), Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/collections/create": {{
+		Method: http.MethodPost, Path: "/favorites/collections/create", OperationID: "createFavoritesCollection", Tag: "favorites",
+		Summary: "Create a collection of favorites",
+		Form:    []models.ParamDoc{collectionNameField, returnURLField}, Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/collections/rename": {{
+		Method: http.MethodPost, Path: "/favorites/collections/rename", OperationID: "renameFavoritesCollection", Tag: "favorites",
+		Summary: "Rename a collection of favorites",
+		Form:    []models.ParamDoc{collectionField, collectionNameField, returnURLField}, Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/collections/delete": {{
+		Method: http.MethodPost, Path: "/favorites/collections/delete", OperationID: "deleteFavoritesCollection", Tag: "favorites",
+		Summary:     "Delete a collection of favorites",
+		Description: "The default collection cannot be deleted (400).",
+		Form:        []models.ParamDoc{collectionField, returnURLField}, Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/items/move": {{
+		Method: http.MethodPost, Path: "/favorites/items/move", OperationID: "moveFavorite", Tag: "favorites",
+		Summary:     "Move an item within its collection",
+		Description: "Out of range positions are clamped. With Accept: application/json (drag and drop), answers 204 No Content or a JSON error instead of redirecting.",
+		Form: params([]models.ParamDoc{collectionField}, favoriteForm[:2], []models.ParamDoc{
+			{Name: "position", Type: "integer", Required: true, Description: "New position in the collection (1 = first)."},
+			returnURLField,
+		}),
+		Redirect: true,
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/items/edit": {{
+		Method: http.MethodPost, Path: "/favorites/items/edit", OperationID: "editFavorite", Tag: "favorites",
+		Summary: "Edit the note and tags of an item",
+		Form: params([]models.ParamDoc{collectionField}, favoriteForm[:2], []models.ParamDoc{
+			{Name: "note", Type: "string", Description: "Free-text note (up to 500 characters)."},
+			{Name: "tags", Type: "string", Description: "Comma-separated tags (up to 10, 30 characters each, stored in lower case)."},
+			returnURLField,
+		}),
+		Redirect: true,
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/orphans/remove": {{
+		Method: http.MethodPost, Path: "/favorites/orphans/remove", OperationID: "removeOrphanedFavorites", Tag: "favorites",
+		Summary:     "Remove the items that are no longer on the grid",
+		Description: "Removes, from every collection, the drivers and constructors that exist in none of the seasons. Renamed constructors are remapped on load and are not affected.",
+		Form:        []models.ParamDoc{returnURLField}, Redirect: true,
+		Errors: []int{http.StatusMethodNotAllowed, http.StatusInternalServerError, http.StatusBadGateway},
+	}},
+	"/favorites/history": {{
+		Method: http.MethodGet, Path: "/favorites/history", OperationID: "getFavoritesHistory", Tag: "favorites",
+		Summary:      "Favorites history",
+		Description:  "Append-only log of the drivers and teams added to or removed from the favorites of the visitor, newest first: who made the change (account name, or anonymous visitor), what and when. Undone changes are marked.",
+		Params:       []models.ParamDoc{pageParam, historyPerPageParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/undo": {{
+		Method: http.MethodPost, Path: "/favorites/undo", OperationID: "undoFavoriteChange", Tag: "favorites",
+		Summary:     "Undo the last favorite change",
+		Description: "Reverts the latest add or remove that has not been undone yet (a removed item goes back to its place with its note and tags). Undoing again walks further back in the history. The undo is itself recorded in the log.",
+		Form:        []models.ParamDoc{returnURLField}, Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/history/rebuild": {{
+		Method: http.MethodPost, Path: "/favorites/history/rebuild", OperationID: "rebuildFavorites", Tag: "favorites",
+		Summary:     "Rebuild the favorites from the history",
+		Description: "Replays the log of the visitor (latest saved state, then every add and remove) and replaces the stored favorites with the result. Changes made outside the log since the last add or remove are lost.",
+		Redirect:    true, RedirectTo: "/favorites/history?rebuilt={count}",
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/export": {{
+		Method: http.MethodGet, Path: "/favorites/export", OperationID: "exportFavorites", Tag: "favorites",
+		Summary:     "Download the favorites",
+		Description: "Sent as an attachment. JSON keeps every collection and can be imported back; CSV has one row per item (collection_id, collection, kind, id, note, tags, added_at).",
+		Params: []models.ParamDoc{{
+			Name: "format", In: "query", Type: "string", Enum: []string{services.FavoritesFormatJSON, services.FavoritesFormatCSV},
+			Default: services.FavoritesFormatJSON, Description: "File format.",
+		}},
+		ContentTypes: []string{"application/json", "text/csv"}, Response: models.FavoritesExport{},
+		Errors: []int{http.StatusBadRequest, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/import": {{
+		Method: http.MethodPost, Path: "/favorites/import", OperationID: "importFavorites", Tag: "favorites",
+		Summary:     "Import favorites from a file",
+		Description: "Accepts a JSON or CSV export of this site, or a legacy {\"drivers\": [...], \"constructors\": [...]} file. Every driver and constructor identifier must exist in one of the seasons: a single invalid entry rejects the whole file (400, problems listed in the message).",
+		Form: []models.ParamDoc{
+			{Name: "file", Type: "string", Format: "binary", Required: true, Description: "File to import (up to 1 MB)."},
+			{Name: "mode", Type: "string", Enum: []string{services.FavoritesImportMerge, services.FavoritesImportReplace}, Default: services.FavoritesImportMerge,
+				Description: "Merge with the current favorites (collections matched by name, duplicates skipped) or replace them."},
+		},
+		Multipart: true, Redirect: true, RedirectTo: "/favorites?imported={count}",
+		Errors: []int{http.StatusBadRequest, http.StatusMethodNotAllowed, http.StatusRequestEntityTooLarge, http.StatusInternalServerError, http.StatusBadGateway},
+	}},
+	"/favorites/share": {{
+		Method: http.MethodPost, Path: "/favorites/share", OperationID: "shareFavorites", Tag: "favorites",
+		Summary:     "Create a read-only share link",
+		Description: "Mints an unguessable /shared/{token} link to the favorites of the visitor. Any previous link stops working.",
+		Form:        []models.ParamDoc{returnURLField}, Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/favorites/unshare": {{
+		Method: http.MethodPost, Path: "/favorites/unshare", OperationID: "unshareFavorites", Tag: "favorites",
+		Summary: "Revoke the share link",
+		Form:    []models.ParamDoc{returnURLField}, Redirect: true,
+		Errors: []int{http.StatusBadRequest, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/shared/": {{
+		Method: http.MethodGet, Path: "/shared/{token}", OperationID: "getSharedFavoritesPage", Tag: "favorites",
+		Summary:      "Shared favorites page",
+		Description:  "Read-only copy of the favorites page of the visitor who created the link. Unknown or revoked tokens return 404.",
+		Params:       []models.ParamDoc{pathParam("token", "Share token."), seasonParam, favoritesGroupParam, favoritesSortParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/about": {{
+		Method: http.MethodGet, Path: "/about", OperationID: "getAboutPage", Tag: "pages",
+		Summary:      "About page",
+		ContentTypes: htmlPage, Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed},
+	}},
+
+	// Comptes et sessions (accountsRouter).
+	"/account": {{
+		Method: http.MethodGet, Path: "/account", OperationID: "getAccountPage", Tag: "accounts",
+		Summary:      "Account page",
+		Description:  "Log in and registration forms, or the signed-in account with a logout button.",
+		Params:       []models.ParamDoc{seasonParam},
+		ContentTypes: htmlPage, Errors: []int{http.StatusMethodNotAllowed},
+	}},
+	"/register": {{
+		Method: http.MethodPost, Path: "/register", OperationID: "register", Tag: "accounts",
+		Summary:     "Create an account and log in",
+		Description: "Sets the f1_session cookie and moves the anonymous favorites of the visitor into the account. Invalid input (400) or a taken username (409) renders the account page with the error.",
+		Form:        credentialsForm, Redirect: true, RedirectTo: "/account",
+		Errors: []int{http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/login": {{
+		Method: http.MethodPost, Path: "/login", OperationID: "login", Tag: "accounts",
+		Summary:     "Log in",
+		Description: "Sets the f1_session cookie and merges the anonymous favorites of the visitor into the account. Wrong credentials render the account page with a 401 status.",
+		Form:        credentialsForm, Redirect: true, RedirectTo: "/account",
+		Errors: []int{http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/logout": {{
+		Method: http.MethodPost, Path: "/logout", OperationID: "logout", Tag: "accounts",
+		Summary:  "Log out",
+		Redirect: true, RedirectTo: "/",
+		Errors: []int{http.StatusMethodNotAllowed},
+	}},
+
+	// API JSON v1 (apiRouter).
+	"/api/v1/drivers": {{
+		Method: http.MethodGet, Path: "/api/v1/drivers", OperationID: "listDrivers", Tag: "api",
+		Summary:      "List drivers",
+		Description:  "Same filters, order and pagination as the /drivers page. Values of one filter are combined with OR, different filters with AND; facets give, for each value, the number of drivers it would match given the other filters.",
+		Params:       params([]models.ParamDoc{seasonParam}, driverFilterParams, []models.ParamDoc{driverSortParam, orderParam, pageParam, perPageParam, cursorParam}),
+		ContentTypes: jsonBody, Response: models.DriversResponse{}, Headers: paginationHeaders,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/v1/constructors": {{
+		Method: http.MethodGet, Path: "/api/v1/constructors", OperationID: "listConstructors", Tag: "api",
+		Summary:      "List constructors",
+		Params:       params([]models.ParamDoc{seasonParam}, constructorFilterParams, []models.ParamDoc{constructorSortParam, orderParam, pageParam, perPageParam, cursorParam}),
+		ContentTypes: jsonBody, Response: models.ConstructorsResponse{}, Headers: paginationHeaders,
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/v1/search": {{
+		Method: http.MethodGet, Path: "/api/v1/search", OperationID: "search", Tag: "api",
+		Summary:      "Search drivers and constructors",
+		Params:       []models.ParamDoc{searchQueryParam, seasonParam},
+		ContentTypes: jsonBody, Response: models.SearchResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/search/suggest": {{
+		Method: http.MethodGet, Path: "/api/search/suggest", OperationID: "suggest", Tag: "api",
+		Summary:     "Search suggestions as you type",
+		Description: "Drivers and constructors of the season with a word starting with q (case and accents ignored), best matches first. Served from an in-memory index built once per season.",
+		Params: []models.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Text typed so far (prefix of a name, code, number or identifier)."},
+			seasonParam,
+			{Name: "limit", In: "query", Type: "integer", Default: strconv.Itoa(services.DefaultSuggestLimit), Description: "Maximum number of suggestions (capped at " + strconv.Itoa(services.MaxSuggestLimit) + ")."},
+		},
+		ContentTypes: jsonBody, Response: models.SuggestResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/v1/favorites": {{
+		Method: http.MethodGet, Path: "/api/v1/favorites", OperationID: "getFavorites", Tag: "api",
+		Summary:      "Favorites of a season",
+		Description:  "Saved identifiers and the matching drivers and constructors of the season.",
+		Params:       []models.ParamDoc{seasonParam},
+		ContentTypes: jsonBody, Response: models.FavoritesResponse{},
+		Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/v1/drivers/": {{
+		Method: http.MethodGet, Path: "/api/v1/drivers/{id}", OperationID: "getDriver", Tag: "api",
+		Summary:      "Get a driver and their constructor",
+		Params:       []models.ParamDoc{pathParam("id", "Driver identifier."), seasonParam},
+		ContentTypes: jsonBody, Response: models.DriverResponse{},
+		Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/v1/constructors/": {{
+		Method: http.MethodGet, Path: "/api/v1/constructors/{id}", OperationID: "getConstructor", Tag: "api",
+		Summary:      "Get a constructor and its drivers",
+		Params:       []models.ParamDoc{pathParam("id", "Constructor identifier."), seasonParam},
+		ContentTypes: jsonBody, Response: models.ConstructorResponse{},
+		Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+	}},
+	"/api/": {},
+
+	// Miroir compatible Ergast (ergastRouter).
+	"/ergast/f1/": {
+		{
+			Method: http.MethodGet, Path: "/ergast/f1/{season}/drivers", OperationID: "ergastDrivers", Tag: "ergast",
+			Summary:      "Drivers in Ergast format",
+			Description:  "season also accepts \"current\".",
+			Params:       params([]models.ParamDoc{seasonPathParam}, ergastParams),
+			ContentTypes: []string{"application/json", "application/xml"}, Response: models.MRData{},
+			Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+		},
+		{
+			Method: http.MethodGet, Path: "/ergast/f1/{season}/drivers/{driverId}", OperationID: "ergastDriver", Tag: "ergast",
+			Summary:      "One driver in Ergast format",
+			Params:       params([]models.ParamDoc{seasonPathParam, pathParam("driverId", "Driver identifier.")}, ergastParams),
+			ContentTypes: []string{"application/json", "application/xml"}, Response: models.MRData{},
+			Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+		},
+		{
+			Method: http.MethodGet, Path: "/ergast/f1/{season}/constructors", OperationID: "ergastConstructors", Tag: "ergast",
+			Summary:      "Constructors in Ergast format",
+			Description:  "season also accepts \"current\".",
+			Params:       params([]models.ParamDoc{seasonPathParam}, ergastParams),
+			ContentTypes: []string{"application/json", "application/xml"}, Response: models.MRData{},
+			Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+		},
+		{
+			Method: http.MethodGet, Path: "/ergast/f1/{season}/constructors/{constructorId}", OperationID: "ergastConstructor", Tag: "ergast",
+			Summary:      "One constructor in Ergast format",
+			Params:       params([]models.ParamDoc{seasonPathParam, pathParam("constructorId", "Constructor identifier.")}, ergastParams),
+			ContentTypes: []string{"application/json", "application/xml"}, Response: models.MRData{},
+			Errors: []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError},
+		},
+	},
+
+	// Endpoint GraphQL (graphqlRouter).
+	"/graphql": {
+		{
+			Method: http.MethodGet, Path: "/graphql", OperationID: "graphqlQuery", Tag: "graphql",
+			Summary:     "Run a GraphQL query",
+			Description: "Queries only (mutations must use POST). Schema: drivers(season, filter, sort, page, perPage), driver(id), constructors, constructor(id){ drivers }, search(q), favorites. Maximum query depth: 6.",
+			Params: []models.ParamDoc{
+				{Name: "query", In: "query", Type: "string", Required: true, Description: "GraphQL document."},
+				{Name: "operationName", In: "query", Type: "string"},
+				{Name: "variables", In: "query", Type: "string", Description: "Variables as a JSON object."},
+			},
+			ContentTypes: jsonBody, Response: models.GraphQLResponse{},
+			Errors: []int{http.StatusBadRequest, http.StatusMethodNotAllowed},
+		},
+		{
+			Method: http.MethodPost, Path: "/graphql", OperationID: "graphqlExecute", Tag: "graphql",
+			Summary:      "Run a GraphQL query or mutation",
+			Description:  "Mutations: addFavoriteDriver, removeFavoriteDriver, addFavoriteConstructor, removeFavoriteConstructor (they require the X-CSRF-Token header). A raw application/graphql body is also accepted; other content types get 415, and a browser Origin other than this site gets 403.",
+			RequestBody:  models.GraphQLRequest{},
+			ContentTypes: jsonBody, Response: models.GraphQLResponse{},
+			Errors: []int{http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType},
+		},
+	},
+
+	// Documentation de l'API (docsRouter).
+	"/api/docs": {{
+		Method: http.MethodGet, Path: "/api/docs", OperationID: "getAPIDocs", Tag: "docs",
+		Summary:      "Interactive API documentation",
+		ContentTypes: htmlPage, Errors: []int{http.StatusMethodNotAllowed},
+	}},
+	"/api/docs/openapi.json": {{
+		Method: http.MethodGet, Path: "/api/docs/openapi.json", OperationID: "getOpenAPIJSON", Tag: "docs",
+		Summary:      "This OpenAPI document (JSON)",
+		ContentTypes: jsonBody,
+		Errors:       []int{http.StatusMethodNotAllowed},
+	}},
+	"/api/docs/openapi.yaml": {{
+		Method: http.MethodGet, Path: "/api/docs/openapi.yaml", OperationID: "getOpenAPIYAML", Tag: "docs",
+		Summary:      "This OpenAPI document (YAML)",
+		ContentTypes: []string{"application/yaml"},
+		Errors:       []int{http.StatusMethodNotAllowed},
+	}},
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// SessionDuration est la durée de validité d'une session de connexion.
+const SessionDuration = 30 * 24 * time.Hour
+
+// Longueurs autorisées pour les mots de passe (bcrypt ignore au-delà de 72 octets).
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72
+)
+
+// Erreurs de validation et d'authentification des comptes.
+var (
+	ErrInvalidUsername    = errors.New("nom d'utilisateur invalide (3 à 32 caractères : lettres, chiffres, . _ -)")
+	ErrInvalidPassword    = errors.New("mot de passe invalide (8 à 72 caractères)")
+	ErrInvalidCredentials = errors.New("nom d'utilisateur ou mot de passe incorrect")
+)
+
+// usernamePattern décrit les noms d'utilisateur acceptés (après passage en minuscules).
+var usernamePattern = regexp.MustCompile(`^[a-z0-9._-]{3,32}$`)
+
+// anonymousIDPattern décrit les identifiants anonymes générés par NewAnonymousID.
+var anonymousIDPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// dummyPasswordHash sert à comparer un mot de passe quand le compte n'existe pas (temps de réponse identique).
+var (
+	dummyPasswordHash     []byte
+	dummyPasswordHashOnce sync.Once
+)
+
+// viewerContextKey est la clé du visiteur dans le contexte d'une requête.
+type viewerContextKey struct{}
+
+// RegisterUser
+// ------------
+// Objectif :
+//   - Valider le nom d'utilisateur (mis en minuscules) et le mot de passe.
+//   - Créer le compte avec un mot de passe haché par bcrypt.
+//   - Retourner 400 (saisie invalide), 409 (nom déjà pris) ou 500.
+func RegisterUser(username, password string) (*models.User, int, error) {
+
+	// Étape 1 : Valider la saisie.
+	username = normalizeUsername(username)
+	if !usernamePattern.MatchString(username) {
+		return nil, http.StatusBadRequest, ErrInvalidUsername
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return nil, http.StatusBadRequest, ErrInvalidPassword
+	}
+
+	// Étape 2 : Hacher le mot de passe et créer le compte.
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	user := &models.User{
+		ID:           randomHex(16),
+		Username:     username,
+		PasswordHash: string(hash),
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := userStore.CreateUser(user); err != nil {
+		if errors.Is(err, ErrUsernameTaken) {
+			return nil, http.StatusConflict, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	return user, http.StatusCreated, nil
+}
+
+// AuthenticateUser
+// ----------------
+// Objectif :
+//   - Retrouver le compte et vérifier le mot de passe avec bcrypt.
+//   - Comparer avec un hash factice si le compte n'existe pas (pas d'indice sur les noms existants).
+//   - Retourner 401 si les identifiants sont incorrects.
+func AuthenticateUser(username, password string) (*models.User, int, error) {
+	user, err := userStore.FindUserByUsername(normalizeUsername(username))
+	if err != nil && !errors.Is(err, ErrUserNotFound) {
+		return nil, http.StatusInternalServerError, err
+	}
+	if user == nil {
+		dummyPasswordHashOnce.Do(func() {
+			dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("f1-app-dummy-password"), bcrypt.DefaultCost)
+		})
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+		return nil, http.StatusUnauthorized, ErrInvalidCredentials
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		return nil, http.StatusUnauthorized, ErrInvalidCredentials
+	}
+	return user, http.StatusOK, nil
+}
+
+// StartSession
+// Ouvre une session pour un compte et retourne le jeton à placer dans le cookie (seul son hash est stocké).
+func StartSession(userID string) (string, time.Time, error) {
+	token := base64.RawURLEncoding.EncodeToString(randomBytes(32))
+	now := time.Now().UTC()
+	session := &models.UserSession{
+		TokenHash: hashSessionToken(token),
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(SessionDuration),
+	}
+	if err := userStore.CreateSession(session); err != nil {
+		return "", time.Time{}, err
+	}
+	return token, session.ExpiresAt, nil
+}
+
+// SessionUser
+// Retourne le compte associé au jeton d'un cookie de session (nil si la session est inconnue ou expirée).
+func SessionUser(token string) *models.User {
+	if token == "" {
+		return nil
+	}
+	session, err := userStore.FindSession(hashSessionToken(token))
+	if err != nil {
+		return nil
+	}
+	if !session.ExpiresAt.After(time.Now()) {
+		_ = userStore.DeleteSession(session.TokenHash)
+		return nil
+	}
+	user, err := userStore.FindUserByID(session.UserID)
+	if err != nil {
+		return nil
+	}
+	return user
+}
+
+// EndSession
+// Ferme la session associée au jeton d'un cookie.
+func EndSession(token string) error {
+	if token == "" {
+		return nil
+	}
+	return userStore.DeleteSession(hashSessionToken(token))
+}
+
+// NewAnonymousID
+// Génère l'identifiant d'un visiteur anonyme (cookie).
+func NewAnonymousID() string {
+	return randomHex(16)
+}
+
+// IsValidAnonymousID
+// Indique si la valeur d'un cookie est un identifiant anonyme bien formé.
+func IsValidAnonymousID(id string) bool {
+	return anonymousIDPattern.MatchString(id)
+}
+
+// UserFavoritesOwner
+// Retourne la clé des favoris d'un compte.
+func UserFavoritesOwner(userID string) string {
+	return "user:" + userID
+}
+
+// AnonymousFavoritesOwner
+// Retourne la clé des favoris d'un visiteur anonyme.
+func AnonymousFavoritesOwner(anonymousID string) string {
+	return "anon:" + anonymousID
+}
+
+// FavoritesOwner
+// Retourne la clé des favoris du visiteur : son compte s'il est connecté, sinon son cookie anonyme ("" si aucun).
+func FavoritesOwner(viewer *models.Viewer) string {
+	switch {
+	case viewer == nil:
+		return ""
+	case viewer.User != nil:
+		return UserFavoritesOwner(viewer.User.ID)
+	case viewer.AnonymousID != "":
+		return AnonymousFavoritesOwner(viewer.AnonymousID)
+	}
+	return ""
+}
+
+// WithViewer
+// Retourne un contexte portant le visiteur de la requête.
+func WithViewer(ctx context.Context, viewer *models.Viewer) context.Context {
+	return context.WithValue(ctx, viewerContextKey{}, viewer)
+}
+
+// ViewerFromContext
+// Retourne le visiteur de la requête (visiteur vide si le contexte n'en porte pas).
+func ViewerFromContext(ctx context.Context) *models.Viewer {
+	if viewer, ok := ctx.Value(viewerContextKey{}).(*models.Viewer); ok && viewer != nil {
+		return viewer
+	}
+	return &models.Viewer{}
+}
+
+// MigrateSharedFavorites
+// ----------------------
+// Objectif :
+//   - Confier au compte désigné par l'administrateur (F1_SHARED_FAVORITES_OWNER, lu au démarrage) les favoris globaux
+//     enregistrés avant l'arrivée des comptes (SharedFavoritesOwner), plutôt qu'au premier inscrit venu.
+//   - Refuser un compte inexistant (ErrUserNotFound) sans rien déplacer.
+//   - Sans effet une fois la reprise faite : les favoris globaux sont supprimés par MergeFavorites.
+func MigrateSharedFavorites(username string) error {
+	user, err := userStore.FindUserByUsername(normalizeUsername(username))
+	if err != nil {
+		return fmt.Errorf("compte %q : %w", username, err)
+	}
+	return MergeFavorites(SharedFavoritesOwner, UserFavoritesOwner(user.ID))
+}
+
+// MergeFavorites
+// --------------
+// Objectif :
+//   - Ajouter les favoris d'un propriétaire (ex. visiteur anonyme) à ceux d'un autre (ex. compte), sans doublon.
+//   - Rapprocher les collections de même nom et conserver notes, tags et dates d'ajout.
+//   - Supprimer ensuite les favoris de la source (une fusion interrompue peut être rejouée sans effet de bord).
+func MergeFavorites(from, to string) error {
+	if from == "" || from == to {
+		return nil
+	}
+	source, err := favoritesStore.Load(from)
+	if err != nil {
+		return err
+	}
+	if len(source.Drivers) == 0 && len(source.Constructors) == 0 && len(source.Collections) <= 1 {
+		return nil
+	}
+	err = favoritesStore.Update(to, func(favorites *models.Favorites) error {
+		mergeFavoriteCollections(favorites, source)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return favoritesStore.Delete(from)
+}
+
+// normalizeUsername
+// Met un nom d'utilisateur en minuscules sans espaces autour (noms insensibles à la casse).
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// hashSessionToken
+// Retourne le hash SHA-256 (hexadécimal) d'un jeton de session.
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomBytes
+// Retourne n octets aléatoires (crypto/rand).
+func randomBytes(n int) []byte {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		panic("crypto/rand indisponible : " + err.Error())
+	}
+	return buf
+}
+
+// randomHex
+// Retourne n octets aléatoires encodés en hexadécimal.
+func randomHex(n int) string {
+	return hex.EncodeToString(randomBytes(n))
+}
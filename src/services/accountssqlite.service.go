@@ -0,0 +1,106 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SQLiteUserStore
+// Stockage des comptes et sessions dans la base SQLite des favoris (tables users et sessions, migration 2).
+type SQLiteUserStore struct {
+	db *sql.DB
+}
+
+// Users
+// Retourne le stockage des comptes partageant la base des favoris.
+func (s *SQLiteFavoritesStore) Users() *SQLiteUserStore {
+	return &SQLiteUserStore{db: s.db}
+}
+
+// CreateUser
+// Enregistre un nouveau compte (ErrUsernameTaken si le nom existe déjà).
+func (s *SQLiteUserStore) CreateUser(user *models.User) error {
+	_, err := s.db.Exec(`INSERT INTO users (id, username, password_hash, created_at) VALUES (?, ?, ?, ?)`,
+		user.ID, user.Username, user.PasswordHash, user.CreatedAt.UTC().Format(time.RFC3339))
+	if err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed: users.username") {
+		return ErrUsernameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("erreur création compte: %w", err)
+	}
+	return nil
+}
+
+// FindUserByID
+// Retourne le compte d'identifiant donné (ErrUserNotFound sinon).
+func (s *SQLiteUserStore) FindUserByID(id string) (*models.User, error) {
+	return s.findUser(`SELECT id, username, password_hash, created_at FROM users WHERE id = ?`, id)
+}
+
+// FindUserByUsername
+// Retourne le compte de nom donné (ErrUserNotFound sinon).
+func (s *SQLiteUserStore) FindUserByUsername(username string) (*models.User, error) {
+	return s.findUser(`SELECT id, username, password_hash, created_at FROM users WHERE username = ?`, username)
+}
+
+// CreateSession
+// Enregistre une session et purge les sessions expirées.
+func (s *SQLiteUserStore) CreateSession(session *models.UserSession) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	if _, err := s.db.Exec(`DELETE FROM sessions WHERE expires_at <= ?`, now); err != nil {
+		return fmt.Errorf("erreur purge sessions: %w", err)
+	}
+	_, err := s.db.Exec(`INSERT INTO sessions (token_hash, user_id, created_at, expires_at) VALUES (?, ?, ?, ?)`,
+		session.TokenHash, session.UserID, session.CreatedAt.UTC().Format(time.RFC3339), session.ExpiresAt.UTC().Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("erreur création session: %w", err)
+	}
+	return nil
+}
+
+// FindSession
+// Retourne la session de hash donné (ErrSessionNotFound sinon).
+func (s *SQLiteUserStore) FindSession(tokenHash string) (*models.UserSession, error) {
+	var session models.UserSession
+	var createdAt, expiresAt string
+	err := s.db.QueryRow(`SELECT token_hash, user_id, created_at, expires_at FROM sessions WHERE token_hash = ?`, tokenHash).
+		Scan(&session.TokenHash, &session.UserID, &createdAt, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture session: %w", err)
+	}
+	session.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	session.ExpiresAt, _ = time.Parse(time.RFC3339, expiresAt)
+	return &session, nil
+}
+
+// DeleteSession
+// Supprime une session (sans erreur si elle n'existe pas).
+func (s *SQLiteUserStore) DeleteSession(tokenHash string) error {
+	if _, err := s.db.Exec(`DELETE FROM sessions WHERE token_hash = ?`, tokenHash); err != nil {
+		return fmt.Errorf("erreur suppression session: %w", err)
+	}
+	return nil
+}
+
+// findUser
+// Exécute une requête retournant un compte (ErrUserNotFound si aucune ligne).
+func (s *SQLiteUserStore) findUser(query string, arg string) (*models.User, error) {
+	var user models.User
+	var createdAt string
+	err := s.db.QueryRow(query, arg).Scan(&user.ID, &user.Username, &user.PasswordHash, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture compte: %w", err)
+	}
+	user.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	return &user, nil
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"f1-app/helpers"
+	"f1-app/models"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// accountsFileName est le nom du fichier des comptes du stockage JSON (à côté du fichier des favoris).
+const accountsFileName = "users.json"
+
+// Erreurs des stockages de comptes.
+var (
+	ErrUserNotFound    = errors.New("utilisateur introuvable")
+	ErrUsernameTaken   = errors.New("nom d'utilisateur déjà utilisé")
+	ErrSessionNotFound = errors.New("session introuvable")
+)
+
+// UserStore
+// Interface d'un stockage des comptes utilisateurs et des sessions (fichier JSON, base SQLite).
+type UserStore interface {
+	CreateUser(user *models.User) error
+	FindUserByID(id string) (*models.User, error)
+	FindUserByUsername(username string) (*models.User, error)
+	CreateSession(session *models.UserSession) error
+	FindSession(tokenHash string) (*models.UserSession, error)
+	DeleteSession(tokenHash string) error
+}
+
+// userStore est le stockage des comptes (fichier users.json par défaut).
+var userStore UserStore = NewJSONUserStore("")
+
+// UseUserStore
+// Remplace le stockage des comptes (appelé au démarrage selon la configuration).
+func UseUserStore(store UserStore) {
+	userStore = store
+}
+
+// OpenUserStore
+// Retourne le stockage des comptes associé au stockage des favoris : la même base SQLite, ou users.json à côté du fichier JSON.
+func OpenUserStore(favorites FavoritesStore) UserStore {
+	switch store := favorites.(type) {
+	case *SQLiteFavoritesStore:
+		return store.Users()
+	case *JSONFavoritesStore:
+		if store.Path != "" {
+			return NewJSONUserStore(filepath.Join(filepath.Dir(store.Path), accountsFileName))
+		}
+	}
+	return NewJSONUserStore("")
+}
+
+// JSONUserStore
+// Stockage des comptes et sessions dans un fichier JSON, avec les mêmes garanties que le stockage JSON des favoris
+// (verrous, écriture atomique). Les sessions expirées sont purgées à chaque écriture.
+type JSONUserStore struct {
+	// Path est le chemin du fichier (vide : users.json à côté de GetFavoritesFilePath).
+	Path string
+	mu   sync.Mutex
+}
+
+// NewJSONUserStore
+// Crée un stockage JSON des comptes sur le fichier donné (vide : emplacement par défaut).
+func NewJSONUserStore(path string) *JSONUserStore {
+	return &JSONUserStore{Path: path}
+}
+
+// CreateUser
+// Enregistre un nouveau compte (ErrUsernameTaken si le nom existe déjà).
+func (s *JSONUserStore) CreateUser(user *models.User) error {
+	return s.update(func(file *models.AccountsFile) error {
+		for _, existing := range file.Users {
+			if existing.Username == user.Username {
+				return ErrUsernameTaken
+			}
+		}
+		file.Users = append(file.Users, *user)
+		return nil
+	})
+}
+
+// FindUserByID
+// Retourne le compte d'identifiant donné (ErrUserNotFound sinon).
+func (s *JSONUserStore) FindUserByID(id string) (*models.User, error) {
+	return s.findUser(func(user models.User) bool { return user.ID == id })
+}
+
+// FindUserByUsername
+// Retourne le compte de nom donné (ErrUserNotFound sinon).
+func (s *JSONUserStore) FindUserByUsername(username string) (*models.User, error) {
+	return s.findUser(func(user models.User) bool { return user.Username == username })
+}
+
+// CreateSession
+// Enregistre une session.
+func (s *JSONUserStore) CreateSession(session *models.UserSession) error {
+	return s.update(func(file *models.AccountsFile) error {
+		file.Sessions = append(file.Sessions, *session)
+		return nil
+	})
+}
+
+// FindSession
+// Retourne la session de hash donné (ErrSessionNotFound sinon).
+func (s *JSONUserStore) FindSession(tokenHash string) (*models.UserSession, error) {
+	file, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range file.Sessions {
+		if session.TokenHash == tokenHash {
+			return &session, nil
+		}
+	}
+	return nil, ErrSessionNotFound
+}
+
+// DeleteSession
+// Supprime une session (sans erreur si elle n'existe pas).
+func (s *JSONUserStore) DeleteSession(tokenHash string) error {
+	return s.update(func(file *models.AccountsFile) error {
+		kept := file.Sessions[:0]
+		for _, session := range file.Sessions {
+			if session.TokenHash != tokenHash {
+				kept = append(kept, session)
+			}
+		}
+		file.Sessions = kept
+		return nil
+	})
+}
+
+// filePath
+// Retourne le chemin du fichier des comptes.
+func (s *JSONUserStore) filePath() string {
+	if s.Path == "" {
+		return filepath.Join(filepath.Dir(GetFavoritesFilePath()), accountsFileName)
+	}
+	return s.Path
+}
+
+// findUser
+// Retourne le premier compte qui satisfait match (ErrUserNotFound sinon).
+func (s *JSONUserStore) findUser(match func(user models.User) bool) (*models.User, error) {
+	file, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range file.Users {
+		if match(user) {
+			return &user, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+// read
+// Lit le fichier des comptes sous verrou (contenu vide s'il n'existe pas encore).
+func (s *JSONUserStore) read() (*models.AccountsFile, error) {
+	var file *models.AccountsFile
+	err := s.withLock(func(path string) error {
+		var err error
+		file, err = readAccountsFile(path)
+		return err
+	})
+	return file, err
+}
+
+// update
+// Applique fn au contenu du fichier puis l'enregistre de façon atomique, sous verrou (rien n'est écrit si fn échoue).
+func (s *JSONUserStore) update(fn func(file *models.AccountsFile) error) error {
+	return s.withLock(func(path string) error {
+		file, err := readAccountsFile(path)
+		if err != nil {
+			return err
+		}
+		if err := fn(file); err != nil {
+			return err
+		}
+
+		// Purger les sessions expirées.
+		now := time.Now()
+		active := []models.UserSession{}
+		for _, session := range file.Sessions {
+			if session.ExpiresAt.After(now) {
+				active = append(active, session)
+			}
+		}
+		file.Sessions = active
+
+		data, err := json.MarshalIndent(file, "", "    ")
+		if err != nil {
+			return fmt.Errorf("erreur encodage JSON comptes: %w", err)
+		}
+		if err := helpers.WriteFileAtomic(path, data, 0600); err != nil {
+			return fmt.Errorf("erreur écriture fichier comptes: %w", err)
+		}
+		return nil
+	})
+}
+
+// withLock
+// Exécute fn sous le verrou interne et le verrou de fichier (<fichier>.lock).
+func (s *JSONUserStore) withLock(fn func(path string) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.filePath()
+	unlock, err := helpers.LockFile(path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return fn(path)
+}
+
+// readAccountsFile
+// Lit et décode le fichier des comptes (contenu vide s'il n'existe pas).
+func readAccountsFile(path string) (*models.AccountsFile, error) {
+	file := &models.AccountsFile{Users: []models.User{}, Sessions: []models.UserSession{}}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture fichier comptes: %w", err)
+	}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("erreur décodage JSON comptes: %w", err)
+	}
+	return file, nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"cmp"
+	"f1-app/models"
+	"slices"
+	"strings"
+)
+
+// Ordres supplémentaires de la liste des écuries (en plus du classement et de l'ordre de la liste).
+const (
+	SortName    = "name"
+	SortDrivers = "drivers"
+)
+
+// ConstructorSortOptions liste les ordres proposés sur la liste des écuries, dans l'ordre d'affichage.
+var ConstructorSortOptions = []models.SortOption{
+	{Value: SortStandings, Label: "Standings"},
+	{Value: SortRoster, Label: "Roster"},
+	{Value: SortName, Label: "Name"},
+	{Value: SortDrivers, Label: "Roster size"},
+}
+
+// constructorFacets liste les facettes des écuries, dans l'ordre d'affichage.
+var constructorFacets = []listFacet[models.Constructor]{
+	{name: "nationality", label: "Nationality", value: func(c models.Constructor) string { return c.Nationality }},
+	{name: "engine", label: "Engine", value: func(c models.Constructor) string { return c.Engine }},
+}
+
+// ConstructorSortKeys
+// Retourne les valeurs acceptées par le paramètre sort de la liste des écuries.
+func ConstructorSortKeys() []string {
+	keys := []string{}
+	for _, option := range ConstructorSortOptions {
+		keys = append(keys, option.Value)
+	}
+	return keys
+}
+
+// ResolveConstructorSort
+// Retourne l'ordre demandé pour la liste des écuries, ou le classement par défaut si la valeur est vide ou inconnue.
+func ResolveConstructorSort(sortParam string) string {
+	if slices.Contains(ConstructorSortKeys(), sortParam) {
+		return sortParam
+	}
+	return SortStandings
+}
+
+// constructorQuery
+// Prépare les filtres de la liste des écuries pour le moteur de requête : facettes et valeurs sélectionnées.
+func constructorQuery(filters models.ConstructorFilters) listQuery[models.Constructor] {
+	return listQuery[models.Constructor]{
+		facets: constructorFacets,
+		selected: map[string][]string{
+			"nationality": filters.Nationality,
+			"engine":      filters.Engine,
+		},
+	}
+}
+
+// constructorSortKey
+// ------------------
+// Objectif :
+//   - Retourner la clé de tri des écuries : classement, liste, nom ou nombre de pilotes (à égalité : par nom).
+//   - Signaler les écuries non classées, placées en fin de liste pour le tri par classement.
+func constructorSortKey(sortKey string, standings map[string]*models.ConstructorStanding, rosterSizes map[string]int) listSortKey[models.Constructor] {
+	switch sortKey {
+	case SortRoster:
+		return listSortKey[models.Constructor]{}
+	case SortStandings:
+		return listSortKey[models.Constructor]{
+			missing: func(c models.Constructor) bool { return standings[c.ConstructorID] == nil },
+			compare: func(a, b models.Constructor) int {
+				return cmp.Compare(standings[a.ConstructorID].Position, standings[b.ConstructorID].Position)
+			},
+		}
+	case SortDrivers:
+		return listSortKey[models.Constructor]{
+			compare: func(a, b models.Constructor) int {
+				return cmp.Or(cmp.Compare(rosterSizes[a.ConstructorID], rosterSizes[b.ConstructorID]), compareConstructorNames(a, b))
+			},
+		}
+	}
+	return listSortKey[models.Constructor]{compare: compareConstructorNames}
+}
+
+// compareConstructorNames
+// Compare deux écuries par nom (sans tenir compte de la casse ni des accents).
+func compareConstructorNames(a, b models.Constructor) int {
+	return strings.Compare(foldSearchText(a.Name), foldSearchText(b.Name))
+}
+
+// constructorRosterSizes
+// Retourne le nombre de pilotes de chaque écurie de la saison, indexé par identifiant (vide si les pilotes sont indisponibles).
+func constructorRosterSizes(season string, constructors []models.Constructor) map[string]int {
+	sizes := map[string]int{}
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return sizes
+	}
+	for _, constructor := range constructors {
+		sizes[constructor.ConstructorID] = len(index.driversByConstructor[constructor.ConstructorID])
+	}
+	return sizes
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"cmp"
+	"f1-app/models"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ordres supplémentaires de la liste des pilotes (en plus du classement et de l'ordre de la liste).
+const (
+	SortSurname   = "surname"
+	SortNumber    = "number"
+	SortAge       = "age"
+	SortTeam      = "team"
+	SortCode      = "code"
+	SortBirthDate = "dob"
+)
+
+// Sens de tri (paramètre order).
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// DriverSortOptions liste les ordres proposés sur la liste des pilotes, dans l'ordre d'affichage.
+var DriverSortOptions = []models.SortOption{
+	{Value: SortStandings, Label: "Standings"},
+	{Value: SortRoster, Label: "Roster"},
+	{Value: SortSurname, Label: "Surname"},
+	{Value: SortNumber, Label: "Number"},
+	{Value: SortAge, Label: "Age"},
+	{Value: SortTeam, Label: "Team"},
+	{Value: SortCode, Label: "Code"},
+	{Value: SortBirthDate, Label: "Date of birth"},
+}
+
+// DriverSortKeys
+// Retourne les valeurs acceptées par le paramètre sort de la liste des pilotes.
+func DriverSortKeys() []string {
+	keys := []string{}
+	for _, option := range DriverSortOptions {
+		keys = append(keys, option.Value)
+	}
+	return keys
+}
+
+// ResolveDriverSort
+// Retourne l'ordre demandé pour la liste des pilotes, ou le classement par défaut si la valeur est vide ou inconnue.
+func ResolveDriverSort(sortParam string) string {
+	if slices.Contains(DriverSortKeys(), sortParam) {
+		return sortParam
+	}
+	return SortStandings
+}
+
+// ResolveOrder
+// Retourne le sens de tri demandé, ou l'ordre croissant par défaut.
+func ResolveOrder(orderParam string) string {
+	if orderParam == OrderDesc {
+		return OrderDesc
+	}
+	return OrderAsc
+}
+
+// driverSortKey
+// -------------
+// Objectif :
+//   - Retourner la clé de tri des pilotes : classement, liste, nom de famille, numéro, âge, écurie, code ou date de naissance
+//     (l'âge croissant place le plus jeune en premier).
+//   - Signaler les pilotes sans valeur pour la clé (sans numéro, sans code, non classés...), placés en fin de liste.
+func driverSortKey(sortKey string, standings map[string]*models.DriverStanding) listSortKey[models.Driver] {
+	switch sortKey {
+	case SortRoster:
+		return listSortKey[models.Driver]{}
+	case SortStandings:
+		return listSortKey[models.Driver]{
+			missing: func(d models.Driver) bool { return standings[d.DriverID] == nil },
+			compare: func(a, b models.Driver) int {
+				return cmp.Compare(standings[a.DriverID].Position, standings[b.DriverID].Position)
+			},
+		}
+	case SortNumber:
+		return listSortKey[models.Driver]{
+			missing: func(d models.Driver) bool {
+				_, err := strconv.Atoi(d.PermanentNumber)
+				return err != nil
+			},
+			compare: func(a, b models.Driver) int {
+				left, _ := strconv.Atoi(a.PermanentNumber)
+				right, _ := strconv.Atoi(b.PermanentNumber)
+				return cmp.Compare(left, right)
+			},
+		}
+	case SortAge:
+		return listSortKey[models.Driver]{
+			missing: missingBirthDate,
+			compare: func(a, b models.Driver) int { return strings.Compare(b.DateOfBirth, a.DateOfBirth) },
+		}
+	case SortBirthDate:
+		return listSortKey[models.Driver]{
+			missing: missingBirthDate,
+			compare: func(a, b models.Driver) int { return strings.Compare(a.DateOfBirth, b.DateOfBirth) },
+		}
+	case SortCode:
+		return listSortKey[models.Driver]{
+			missing: func(d models.Driver) bool { return d.Code == "" },
+			compare: func(a, b models.Driver) int { return strings.Compare(a.Code, b.Code) },
+		}
+	case SortTeam:
+		return listSortKey[models.Driver]{
+			missing: func(d models.Driver) bool { return d.Team == "" },
+			compare: func(a, b models.Driver) int {
+				return cmp.Or(strings.Compare(foldSearchText(a.Team), foldSearchText(b.Team)), compareSurnames(a, b))
+			},
+		}
+	}
+	return listSortKey[models.Driver]{compare: compareSurnames}
+}
+
+// missingBirthDate
+// Indique si la date de naissance d'un pilote est absente ou invalide.
+func missingBirthDate(driver models.Driver) bool {
+	_, ok := driverBirthDate(driver)
+	return !ok
+}
+
+// compareSurnames
+// Compare deux pilotes par nom de famille puis prénom (sans tenir compte de la casse ni des accents).
+func compareSurnames(a, b models.Driver) int {
+	return cmp.Or(
+		strings.Compare(foldSearchText(a.FamilyName), foldSearchText(b.FamilyName)),
+		strings.Compare(foldSearchText(a.GivenName), foldSearchText(b.GivenName)),
+	)
+}
+
+// driverBirthDate
+// Retourne la date de naissance d'un pilote (ok=false si elle est absente ou invalide).
+func driverBirthDate(driver models.Driver) (time.Time, bool) {
+	birth, err := time.Parse("2006-01-02", driver.DateOfBirth)
+	return birth, err == nil
+}
+
+// driverAge
+// Retourne l'âge d'un pilote à une date donnée (ok=false si sa date de naissance est inconnue).
+func driverAge(driver models.Driver, reference time.Time) (int, bool) {
+	birth, ok := driverBirthDate(driver)
+	if !ok {
+		return 0, false
+	}
+	age := reference.Year() - birth.Year()
+	if reference.Month() < birth.Month() || (reference.Month() == birth.Month() && reference.Day() < birth.Day()) {
+		age--
+	}
+	return age, true
+}
+
+// ageReferenceDate
+// Date à laquelle l'âge des pilotes est calculé : aujourd'hui pour la saison en cours, le 31 décembre pour une saison passée.
+func ageReferenceDate(season string) time.Time {
+	now := time.Now()
+	year, err := strconv.Atoi(season)
+	if err != nil || year >= now.Year() {
+		return now
+	}
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+}
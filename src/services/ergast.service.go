@@ -0,0 +1,165 @@
+package services
+
+import (
+	"f1-app/models"
+	"net/http"
+	"strconv"
+)
+
+// ErgastXMLNamespace est l'espace de noms des réponses Ergast (attribut xmlns de MRData).
+const ErgastXMLNamespace = "http://ergast.com/mrd/1.5"
+
+// Pagination des réponses au format Ergast : 30 éléments par défaut, 100 au maximum.
+const (
+	ergastDefaultLimit = 30
+	ergastMaxLimit     = 100
+)
+
+// ErgastSeason
+// Traduit le segment de saison d'une URL Ergast ("current" désigne la saison par défaut).
+func ErgastSeason(season string) string {
+	if season == "current" {
+		return DefaultSeason
+	}
+	return season
+}
+
+// GetErgastDriversService
+// -----------------------
+// Objectif :
+//   - Construire une réponse MRData/DriverTable au format Ergast avec nos pilotes enrichis.
+//   - Restreindre la table à un pilote si driverID est fourni.
+//   - Appliquer limit/offset comme l'API Ergast (30 par défaut, 100 au maximum).
+//   - Comme Ergast, une saison inconnue donne une table vide (total 0) et non une erreur.
+func GetErgastDriversService(season, driverID, limitParam, offsetParam, requestURL string) (*models.MRData, int, error) {
+
+	// Étape 1 : Récupérer les pilotes de la saison depuis son index (table vide si la saison est inconnue).
+	var drivers []models.Driver
+	index, err := seasonIndexFor(season)
+	if err != nil && sourceErrorStatus(err) != http.StatusNotFound {
+		return nil, sourceErrorStatus(err), err
+	}
+	if index != nil {
+		drivers = index.drivers
+	}
+
+	// Étape 2 : Restreindre au pilote demandé.
+	if driverID != "" {
+		drivers = filterDrivers(drivers, driverID)
+	}
+
+	// Étape 3 : Découper la page demandée et construire l'enveloppe.
+	limit, offset := ergastPageParams(limitParam, offsetParam)
+	start, end := ergastPageBounds(len(drivers), limit, offset)
+
+	data := newErgastMRData(requestURL, limit, offset, len(drivers))
+	data.DriverTable = &models.DriverTable{
+		Season:   season,
+		DriverID: driverID,
+		Drivers:  append([]models.Driver{}, drivers[start:end]...),
+	}
+	return data, http.StatusOK, nil
+}
+
+// GetErgastConstructorsService
+// ----------------------------
+// Objectif :
+//   - Construire une réponse MRData/ConstructorTable au format Ergast avec nos écuries enrichies.
+//   - Restreindre la table à une écurie si constructorID est fourni.
+//   - Appliquer limit/offset comme l'API Ergast (30 par défaut, 100 au maximum).
+func GetErgastConstructorsService(season, constructorID, limitParam, offsetParam, requestURL string) (*models.MRData, int, error) {
+
+	// Étape 1 : Récupérer les écuries de la saison depuis son index (table vide si la saison est inconnue).
+	var constructors []models.Constructor
+	index, err := seasonIndexFor(season)
+	if err != nil && sourceErrorStatus(err) != http.StatusNotFound {
+		return nil, sourceErrorStatus(err), err
+	}
+	if index != nil {
+		constructors = index.constructors
+	}
+
+	// Étape 2 : Restreindre à l'écurie demandée.
+	if constructorID != "" {
+		constructors = filterConstructors(constructors, constructorID)
+	}
+
+	// Étape 3 : Découper la page demandée et construire l'enveloppe.
+	limit, offset := ergastPageParams(limitParam, offsetParam)
+	start, end := ergastPageBounds(len(constructors), limit, offset)
+
+	data := newErgastMRData(requestURL, limit, offset, len(constructors))
+	data.ConstructorTable = &models.ConstructorTable{
+		Season:        season,
+		ConstructorID: constructorID,
+		Constructors:  append([]models.Constructor{}, constructors[start:end]...),
+	}
+	return data, http.StatusOK, nil
+}
+
+// newErgastMRData
+// Construit l'enveloppe MRData commune (espace de noms, série, URL et pagination en chaînes).
+func newErgastMRData(requestURL string, limit, offset, total int) *models.MRData {
+	return &models.MRData{
+		Xmlns:  ErgastXMLNamespace,
+		Series: "f1",
+		URL:    requestURL,
+		Limit:  strconv.Itoa(limit),
+		Offset: strconv.Itoa(offset),
+		Total:  strconv.Itoa(total),
+	}
+}
+
+// ergastPageParams
+// Lit limit et offset (valeurs invalides ramenées aux valeurs par défaut, limit plafonné à 100).
+func ergastPageParams(limitParam, offsetParam string) (int, int) {
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 {
+		limit = ergastDefaultLimit
+	}
+	if limit > ergastMaxLimit {
+		limit = ergastMaxLimit
+	}
+
+	offset, err := strconv.Atoi(offsetParam)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// ergastPageBounds
+// Retourne les bornes [start, end) de la page dans une liste de total éléments.
+func ergastPageBounds(total, limit, offset int) (int, int) {
+	start := offset
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+// filterDrivers
+// Retourne le pilote correspondant à l'identifiant (liste vide s'il est absent).
+func filterDrivers(drivers []models.Driver, driverID string) []models.Driver {
+	for _, driver := range drivers {
+		if driver.DriverID == driverID {
+			return []models.Driver{driver}
+		}
+	}
+	return []models.Driver{}
+}
+
+// filterConstructors
+// Retourne l'écurie correspondant à l'identifiant (liste vide si elle est absente).
+func filterConstructors(constructors []models.Constructor, constructorID string) []models.Constructor {
+	for _, constructor := range constructors {
+		if constructor.ConstructorID == constructorID {
+			return []models.Constructor{constructor}
+		}
+	}
+	return []models.Constructor{}
+}
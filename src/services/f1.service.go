@@ -1,195 +1,247 @@
 package services
 
 import (
+	"errors"
 	"f1-app/models"
 	"fmt"
 	"net/http"
-	"strings"
+	"sort"
 )
 
-// getDriversData
-// Récupère la liste complète des pilotes depuis les données intégrées.
-func getDriversData() []models.Driver {
-	return models.DriversData
+// ErrDriverNotFound est retournée lorsque le pilote demandé n'existe pas dans la saison.
+var ErrDriverNotFound = errors.New("pilote introuvable")
+
+// ErrConstructorNotFound est retournée lorsque l'écurie demandée n'existe pas dans la saison.
+var ErrConstructorNotFound = errors.New("écurie introuvable")
+
+// DriverQueryResult
+// Structure regroupant le résultat d'une requête sur les pilotes (page courante, options de filtres, classement).
+type DriverQueryResult struct {
+	Season     string
+	Filters    models.DriverFilters
+	Sort       string
+	Order      string
+	Drivers    []models.Driver
+	AllDrivers []models.Driver
+	Facets     []models.Facet
+	Standings  map[string]*models.DriverStanding
+	Pagination models.Pagination
 }
 
-// getConstructorsData
-// Récupère la liste complète des écuries depuis les données intégrées.
-func getConstructorsData() []models.Constructor {
-	return models.ConstructorsData
-}
-
-// GetDriverStandingsService
-// -------------------------
+// QueryDrivers
+// ------------
 // Objectif :
-//   - Récupérer les pilotes avec filtrage (équipe, nationalité, type) et pagination.
-//   - Retourner les données paginées avec les options de filtrage disponibles.
-func GetDriverStandingsService(season, teamFilter, nationalityFilter, driverTypeFilter, pageParam, perPageParam string) (*models.PageData, int, error) {
-
-	// Étape 1 : Récupérer tous les pilotes depuis les données intégrées.
-	allDrivers := getDriversData()
-
-	// Étape 2 : Appliquer les filtres de recherche.
-	filteredDrivers := []models.Driver{}
-	for _, driver := range allDrivers {
-
-		if teamFilter != "" && driver.Team != teamFilter {
-			continue
-		}
-
-		if nationalityFilter != "" && driver.Nationality != nationalityFilter {
-			continue
-		}
-
-		if driverTypeFilter != "" && driver.DriverType != driverTypeFilter {
-			continue
-		}
-		filteredDrivers = append(filteredDrivers, driver)
+//   - Récupérer les pilotes avec filtrage (équipe, nationalité, type, décennie de naissance, rookie ; plusieurs valeurs
+//     par filtre ; tranche d'âge) et pagination (numéro de page ou curseur, voir paginateItems).
+//   - Refuser (400) une valeur de rookie autre que true/false et une décennie mal formée (voir validateDriverFilters).
+//   - Calculer les facettes des filtres (valeurs triées et nombre de pilotes pour chacune, voir listQuery.facetsFor).
+//   - Associer à chaque pilote sa place au championnat (points, victoires, écart avec le leader).
+//   - Trier par classement (par défaut), ordre de la liste (sort=roster) ou l'une des clés de DriverSortOptions,
+//     dans le sens demandé (order=asc|desc).
+//   - Partager la même logique entre les pages HTML et l'API JSON.
+func QueryDrivers(season string, filters models.DriverFilters, sortParam, orderParam string, page models.PageRequest) (*DriverQueryResult, int, error) {
+
+	// Étape 1 : Récupérer tous les pilotes depuis l'index de la saison.
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
 	}
+	allDrivers := index.driverList()
 
-	// Étape 3 : Récupérer les valeurs disponibles pour les filtres (équipes, nationalités, types).
-	teams := getUniqueTeams(allDrivers)
-	nationalities := getUniqueNationalities(allDrivers)
-	driverTypes := getUniqueDriverTypes(allDrivers)
-
-	// Étape 4 : Traiter les paramètres de pagination.
-	page := 1
-	perPage := 10
-
-	if pageParam != "" {
-		if n, err := fmt.Sscanf(pageParam, "%d", &page); err != nil || n != 1 || page < 1 {
-			page = 1
-		}
+	// Étape 2 : Appliquer les filtres de recherche.
+	filters.Team = NormalizeFilterValues(filters.Team)
+	filters.Nationality = NormalizeFilterValues(filters.Nationality)
+	filters.DriverType = NormalizeFilterValues(filters.DriverType)
+	filters.Decade = NormalizeFilterValues(filters.Decade)
+	filters.Rookie = NormalizeFilterValues(filters.Rookie)
+	if err := validateDriverFilters(filters); err != nil {
+		return nil, http.StatusBadRequest, err
 	}
-
-	if perPageParam != "" {
-		if n, err := fmt.Sscanf(perPageParam, "%d", &perPage); err != nil || n != 1 {
-			perPage = 10
-		}
-		if perPage != 10 && perPage != 20 && perPage != 30 {
-			perPage = 10
-		}
+	context := newDriverFacetContext(season)
+	if len(filters.Rookie) > 0 && context.previous == nil {
+		return nil, http.StatusBadRequest, ErrRookieUnavailable
 	}
-
-	// Étape 5 : Calculer les indices de pagination.
-	totalDrivers := len(filteredDrivers)
-	totalPages := (totalDrivers + perPage - 1) / perPage
-
-	if page > totalPages && totalPages > 0 {
-		page = totalPages
+	query := driverQuery(filters, context)
+	filteredDrivers := query.filter(allDrivers)
+
+	// Étape 3 : Calculer le classement et trier les pilotes si demandé.
+	sortKey, order := ResolveDriverSort(sortParam), ResolveOrder(orderParam)
+	standings := getDriverStandingsByID(season)
+	sortItems(filteredDrivers, driverSortKey(sortKey, standings), order)
+
+	// Étape 4 : Découper la page demandée (numéro de page ou curseur).
+	fingerprint := queryFingerprint(season, sortKey, order, filters)
+	drivers, pagination, err := paginateItems(filteredDrivers, func(d models.Driver) string { return d.DriverID }, fingerprint, page)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
 	}
 
-	start := (page - 1) * perPage
-	end := start + perPage
-
-	if start > totalDrivers {
-		start = totalDrivers
-	}
-	if end > totalDrivers {
-		end = totalDrivers
-	}
-
-	paginatedDrivers := filteredDrivers[start:end]
+	// Étape 5 : Retourner le résultat avec les facettes des filtres.
+	return &DriverQueryResult{
+		Season:     season,
+		Filters:    filters,
+		Sort:       sortKey,
+		Order:      order,
+		Drivers:    drivers,
+		AllDrivers: allDrivers,
+		Facets:     query.facetsFor(allDrivers),
+		Standings:  standings,
+		Pagination: pagination,
+	}, http.StatusOK, nil
+}
 
-	startIndex := start + 1
-	endIndex := end
-	if totalDrivers == 0 {
-		startIndex = 0
-		endIndex = 0
+// GetDriverStandingsService
+// -------------------------
+// Objectif :
+//   - Exécuter QueryDrivers avec les filtres et la pagination demandés.
+//   - Retourner les données formatées pour le template "drivers".
+func GetDriverStandingsService(season string, filters models.DriverFilters, sortParam, orderParam string, page models.PageRequest) (*models.PageData, int, error) {
+
+	// Étape 1 : Exécuter la requête sur les pilotes.
+	result, status, err := QueryDrivers(season, filters, sortParam, orderParam, page)
+	if err != nil {
+		return nil, status, err
 	}
 
-	// Étape 6 : Préparer les données pour le template.
+	// Étape 2 : Préparer les données pour le template.
 	pageData := &models.PageData{
 		Title:       fmt.Sprintf("Pilotes %s", season),
 		CurrentPage: "drivers",
 		Data: map[string]interface{}{
 			"season":            season,
-			"drivers":           paginatedDrivers,
-			"allDrivers":        allDrivers,
-			"teams":             teams,
-			"nationalities":     nationalities,
-			"driverTypes":       driverTypes,
-			"currentPage":       page,
-			"perPage":           perPage,
-			"totalPages":        totalPages,
-			"totalDrivers":      totalDrivers,
-			"startIndex":        startIndex,
-			"endIndex":          endIndex,
-			"teamFilter":        teamFilter,
-			"nationalityFilter": nationalityFilter,
-			"driverTypeFilter":  driverTypeFilter,
+			"seasons":           GetSeasons(),
+			"drivers":           result.Drivers,
+			"allDrivers":        result.AllDrivers,
+			"facets":            result.Facets,
+			"currentPage":       result.Pagination.Page,
+			"perPage":           result.Pagination.PerPage,
+			"pageSizes":         PageSizes(),
+			"totalPages":        result.Pagination.TotalPages,
+			"totalDrivers":      result.Pagination.Total,
+			"startIndex":        result.Pagination.StartIndex,
+			"endIndex":          result.Pagination.EndIndex,
+			"teamFilter":        result.Filters.Team,
+			"nationalityFilter": result.Filters.Nationality,
+			"driverTypeFilter":  result.Filters.DriverType,
+			"decadeFilter":      result.Filters.Decade,
+			"rookieFilter":      result.Filters.Rookie,
+			"ageMin":            result.Filters.AgeMin,
+			"ageMax":            result.Filters.AgeMax,
+			"sortOptions":       DriverSortOptions,
+			"order":             result.Order,
+			"standings":         result.Standings,
+			"sort":              result.Sort,
 		},
 	}
 
-	// Étape 7 : Retourner les données avec le statut HTTP OK.
+	// Étape 3 : Retourner les données avec le statut HTTP OK.
 	return pageData, http.StatusOK, nil
 }
 
-// getUniqueTeams
-// Extrait la liste unique des équipes à partir de la liste des pilotes.
-func getUniqueTeams(drivers []models.Driver) []string {
-	teamMap := make(map[string]bool)
-	for _, driver := range drivers {
-		if driver.Team != "" {
-			teamMap[driver.Team] = true
-		}
-	}
-	teams := []string{}
-	for team := range teamMap {
-		teams = append(teams, team)
-	}
-	return teams
+// ConstructorQueryResult
+// Structure regroupant le résultat d'une requête sur les écuries (page courante, facettes des filtres, classement).
+type ConstructorQueryResult struct {
+	Season          string
+	Filters         models.ConstructorFilters
+	Sort            string
+	Order           string
+	Constructors    []models.Constructor
+	AllConstructors []models.Constructor
+	Facets          []models.Facet
+	Standings       map[string]*models.ConstructorStanding
+	RosterSizes     map[string]int
+	Pagination      models.Pagination
 }
 
-// getUniqueNationalities
-// Extrait la liste unique des nationalités à partir de la liste des pilotes.
-func getUniqueNationalities(drivers []models.Driver) []string {
-	nationalityMap := make(map[string]bool)
-	for _, driver := range drivers {
-		if driver.Nationality != "" {
-			nationalityMap[driver.Nationality] = true
-		}
-	}
-	nationalities := []string{}
-	for nationality := range nationalityMap {
-		nationalities = append(nationalities, nationality)
+// QueryConstructors
+// -----------------
+// Objectif :
+//   - Récupérer les écuries avec filtrage (nationalité, motoriste ; plusieurs valeurs par filtre) et pagination
+//     (numéro de page ou curseur), avec le même moteur de requête que la liste des pilotes.
+//   - Calculer les facettes des filtres (valeurs triées et nombre d'écuries pour chacune).
+//   - Associer à chaque écurie sa place au championnat constructeurs et son nombre de pilotes.
+//   - Trier par classement (par défaut), ordre de la liste (sort=roster), nom (sort=name) ou nombre de pilotes
+//     (sort=drivers), dans le sens demandé (order=asc|desc).
+func QueryConstructors(season string, filters models.ConstructorFilters, sortParam, orderParam string, page models.PageRequest) (*ConstructorQueryResult, int, error) {
+
+	// Étape 1 : Récupérer toutes les écuries depuis l'index de la saison.
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
 	}
-	return nationalities
-}
+	allConstructors := index.constructorList()
 
-// getUniqueDriverTypes
-// Extrait la liste unique des types de pilotes à partir de la liste des pilotes.
-func getUniqueDriverTypes(drivers []models.Driver) []string {
-	typeMap := make(map[string]bool)
-	for _, driver := range drivers {
-		if driver.DriverType != "" {
-			typeMap[driver.DriverType] = true
-		}
-	}
-	types := []string{}
-	for driverType := range typeMap {
-		types = append(types, driverType)
+	// Étape 2 : Appliquer les filtres de recherche.
+	filters.Nationality = NormalizeFilterValues(filters.Nationality)
+	filters.Engine = NormalizeFilterValues(filters.Engine)
+	query := constructorQuery(filters)
+	filteredConstructors := query.filter(allConstructors)
+
+	// Étape 3 : Calculer le classement et trier les écuries.
+	sortKey, order := ResolveConstructorSort(sortParam), ResolveOrder(orderParam)
+	standings := getConstructorStandingsByID(season)
+	rosterSizes := constructorRosterSizes(season, allConstructors)
+	sortItems(filteredConstructors, constructorSortKey(sortKey, standings, rosterSizes), order)
+
+	// Étape 4 : Découper la page demandée (numéro de page ou curseur).
+	fingerprint := queryFingerprint(season, sortKey, order, filters)
+	constructors, pagination, err := paginateItems(filteredConstructors, func(c models.Constructor) string { return c.ConstructorID }, fingerprint, page)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
 	}
-	return types
+
+	// Étape 5 : Retourner le résultat avec les facettes des filtres.
+	return &ConstructorQueryResult{
+		Season:          season,
+		Filters:         filters,
+		Sort:            sortKey,
+		Order:           order,
+		Constructors:    constructors,
+		AllConstructors: allConstructors,
+		Facets:          query.facetsFor(allConstructors),
+		Standings:       standings,
+		RosterSizes:     rosterSizes,
+		Pagination:      pagination,
+	}, http.StatusOK, nil
 }
 
 // GetConstructorStandingsService
 // ------------------------------
 // Objectif :
-//   - Récupérer la liste complète des écuries pour une saison.
-//   - Retourner les données formatées pour le template.
-func GetConstructorStandingsService(season string) (*models.PageData, int, error) {
-
-	// Étape 1 : Récupérer tous les écuries depuis les données intégrées.
-	constructors := getConstructorsData()
+//   - Exécuter QueryConstructors avec les filtres, l'ordre et la pagination demandés.
+//   - Retourner les données formatées pour le template "teams".
+func GetConstructorStandingsService(season string, filters models.ConstructorFilters, sortParam, orderParam string, page models.PageRequest) (*models.PageData, int, error) {
+
+	// Étape 1 : Exécuter la requête sur les écuries.
+	result, status, err := QueryConstructors(season, filters, sortParam, orderParam, page)
+	if err != nil {
+		return nil, status, err
+	}
 
 	// Étape 2 : Préparer les données pour le template.
 	pageData := &models.PageData{
 		Title:       fmt.Sprintf("Écuries %s", season),
 		CurrentPage: "teams",
 		Data: map[string]interface{}{
-			"season":       season,
-			"constructors": constructors,
+			"season":            season,
+			"seasons":           GetSeasons(),
+			"constructors":      result.Constructors,
+			"allConstructors":   result.AllConstructors,
+			"facets":            result.Facets,
+			"currentPage":       result.Pagination.Page,
+			"perPage":           result.Pagination.PerPage,
+			"pageSizes":         PageSizes(),
+			"totalPages":        result.Pagination.TotalPages,
+			"totalTeams":        result.Pagination.Total,
+			"startIndex":        result.Pagination.StartIndex,
+			"endIndex":          result.Pagination.EndIndex,
+			"nationalityFilter": result.Filters.Nationality,
+			"engineFilter":      result.Filters.Engine,
+			"sortOptions":       ConstructorSortOptions,
+			"order":             result.Order,
+			"standings":         result.Standings,
+			"rosterSizes":       result.RosterSizes,
+			"sort":              result.Sort,
 		},
 	}
 
@@ -197,62 +249,198 @@ func GetConstructorStandingsService(season string) (*models.PageData, int, error
 	return pageData, http.StatusOK, nil
 }
 
+// GetDriverService
+// ----------------
+// Objectif :
+//   - Rechercher un pilote de la saison par son identifiant (index de la saison).
+//   - Retrouver l'écurie du pilote par son ConstructorID (nil si elle est absente de la saison).
+//   - Retourner 404 (ErrDriverNotFound) si le pilote n'existe pas.
+func GetDriverService(season, driverID string) (*models.Driver, *models.Constructor, int, error) {
+
+	// Étape 1 : Récupérer l'index de la saison et rechercher le pilote demandé.
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, nil, sourceErrorStatus(err), err
+	}
+
+	driver, ok := index.driversByID[driverID]
+	if !ok {
+		return nil, nil, http.StatusNotFound, fmt.Errorf("%w : %s (saison %s)", ErrDriverNotFound, driverID, season)
+	}
+
+	// Étape 2 : Retrouver l'écurie du pilote.
+	team, ok := index.constructorsByID[driver.ConstructorID]
+	if !ok {
+		return &driver, nil, http.StatusOK, nil
+	}
+	return &driver, &team, http.StatusOK, nil
+}
+
+// GetConstructorService
+// ---------------------
+// Objectif :
+//   - Rechercher une écurie de la saison par son identifiant (index de la saison).
+//   - Retrouver les pilotes rattachés à l'écurie par leur ConstructorID.
+//   - Retourner 404 (ErrConstructorNotFound) si l'écurie n'existe pas.
+func GetConstructorService(season, constructorID string) (*models.Constructor, []models.Driver, int, error) {
+
+	// Étape 1 : Récupérer l'index de la saison et rechercher l'écurie demandée.
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, nil, sourceErrorStatus(err), err
+	}
+
+	team, ok := index.constructorsByID[constructorID]
+	if !ok {
+		return nil, nil, http.StatusNotFound, fmt.Errorf("%w : %s (saison %s)", ErrConstructorNotFound, constructorID, season)
+	}
+
+	// Étape 2 : Récupérer les pilotes de l'écurie.
+	return &team, index.driversOf(constructorID), http.StatusOK, nil
+}
+
+// GetSearchService
+// ----------------
+// Objectif :
+//   - Rechercher dans les pilotes et écuries de la saison (voir GetRankedSearch).
+//   - Retourner les fiches triées par pertinence, sans les scores ni le surlignage (API JSON, GraphQL).
+func GetSearchService(season, query string) ([]models.Driver, []models.Constructor, int, error) {
+	results, status, err := GetRankedSearch(season, query)
+	if err != nil {
+		return nil, nil, status, err
+	}
+	drivers, constructors := searchResultItems(results)
+	return drivers, constructors, http.StatusOK, nil
+}
+
+// GetRankedSearch
+// ---------------
+// Objectif :
+//   - Analyser la requête de recherche (400 et *SearchSyntaxError en cas d'erreur de syntaxe).
+//   - Récupérer tous les pilotes et toutes les écuries de la saison.
+//   - Retourner les éléments correspondants triés par pertinence, avec leur score et leurs champs à surligner.
+func GetRankedSearch(season, query string) (models.SearchResults, int, error) {
+
+	// Étape 1 : Analyser la requête avant de charger les données.
+	if _, err := ParseSearchQuery(query); err != nil {
+		return models.SearchResults{}, http.StatusBadRequest, err
+	}
+
+	// Étape 2 : Récupérer TOUTES les données des pilotes et des écuries (index de la saison).
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return models.SearchResults{}, sourceErrorStatus(err), err
+	}
+
+	// Étape 3 : Évaluer et classer les résultats selon la requête.
+	results, err := RankSearch(query, index.drivers, index.constructors)
+	if err != nil {
+		return models.SearchResults{}, http.StatusBadRequest, err
+	}
+	return results, http.StatusOK, nil
+}
+
 // SearchService
-// ------
+// -------------
 // Objectif :
-//   - Filtrer les pilotes et écuries selon une requête de recherche.
-//   - Recherche insensible à la casse et flexible.
-//   - Retourner les pilotes et écuries correspondants.
-func SearchService(query string, driversInterface interface{}, constructorsInterface interface{}) ([]models.Driver, []models.Constructor) {
-	// Étape 1 : Normaliser la requête de recherche.
-	query = strings.ToLower(strings.TrimSpace(query))
-
-	var filteredDrivers []models.Driver
-	var filteredConstructors []models.Constructor
-
-	// Étape 2 : Vérifier que la requête n'est pas vide.
-	if query == "" {
-		return filteredDrivers, filteredConstructors
+//   - Filtrer les pilotes et écuries selon une requête de recherche (voir ParseSearchQuery).
+//   - Retourner les pilotes et écuries correspondants, du plus au moins pertinent, ou l'erreur de syntaxe de la requête.
+func SearchService(query string, driversInterface interface{}, constructorsInterface interface{}) ([]models.Driver, []models.Constructor, error) {
+	drivers, _ := driversInterface.([]models.Driver)
+	constructors, _ := constructorsInterface.([]models.Constructor)
+	results, err := RankSearch(query, drivers, constructors)
+	if err != nil {
+		return nil, nil, err
+	}
+	filteredDrivers, filteredConstructors := searchResultItems(results)
+	return filteredDrivers, filteredConstructors, nil
+}
+
+// RankSearch
+// ----------
+// Objectif :
+//   - Évaluer une requête de recherche sur chaque pilote et chaque écurie : texte libre sans tenir compte de la casse
+//     ni des accents, fautes de frappe tolérées, filtres champ:valeur, négation, OR et parenthèses.
+//   - Trier les résultats par score décroissant (un code, un numéro ou un nom de famille exact passe avant
+//     une nationalité ; l'ordre d'origine départage les égalités).
+//   - Découper les champs affichés en morceaux surlignés ou non.
+func RankSearch(query string, drivers []models.Driver, constructors []models.Constructor) (models.SearchResults, error) {
+	results := models.SearchResults{}
+
+	// Étape 1 : Analyser la requête (une requête vide ne retourne rien).
+	node, err := ParseSearchQuery(query)
+	if err != nil || node == nil {
+		return results, err
 	}
 
-	// Étape 3 : Convertir les interfaces en listes typées.
-	drivers, driversOk := driversInterface.([]models.Driver)
-	constructors, constructorsOk := constructorsInterface.([]models.Constructor)
-
-	// Étape 4 : Rechercher les écuries correspondantes.
-	var matchedTeamNames []string
-	if constructorsOk {
-		for _, constructor := range constructors {
-			if strings.Contains(strings.ToLower(constructor.Name), query) ||
-				strings.Contains(strings.ToLower(constructor.Nationality), query) {
-				filteredConstructors = append(filteredConstructors, constructor)
-				matchedTeamNames = append(matchedTeamNames, constructor.Name)
-			}
+	// Étape 2 : Évaluer l'arbre de la requête sur chaque écurie et chaque pilote.
+	for _, constructor := range constructors {
+		if match, ok := node.matchConstructor(constructor); ok {
+			results.Constructors = append(results.Constructors, models.ConstructorHit{
+				Constructor: constructor,
+				Score:       match.score,
+				Highlights:  constructorHighlights(constructor, match.spans),
+			})
 		}
 	}
+	for _, driver := range drivers {
+		if match, ok := node.matchDriver(driver); ok {
+			results.Drivers = append(results.Drivers, models.DriverHit{
+				Driver:     driver,
+				Score:      match.score,
+				Highlights: driverHighlights(driver, match.spans),
+			})
+		}
+	}
+
+	// Étape 3 : Trier par pertinence.
+	sort.SliceStable(results.Drivers, func(i, j int) bool { return results.Drivers[i].Score > results.Drivers[j].Score })
+	sort.SliceStable(results.Constructors, func(i, j int) bool { return results.Constructors[i].Score > results.Constructors[j].Score })
+	return results, nil
+}
 
-	// Étape 5 : Rechercher les pilotes correspondants.
-	if driversOk {
-		for _, driver := range drivers {
-
-			if strings.Contains(strings.ToLower(driver.GivenName), query) ||
-				strings.Contains(strings.ToLower(driver.FamilyName), query) ||
-				strings.Contains(strings.ToLower(driver.Code), query) ||
-				strings.Contains(driver.PermanentNumber, query) ||
-				strings.Contains(strings.ToLower(driver.Nationality), query) {
-				filteredDrivers = append(filteredDrivers, driver)
-				continue
-			}
-
-			for _, teamName := range matchedTeamNames {
-				if driver.Team == teamName {
-					filteredDrivers = append(filteredDrivers, driver)
-					break
-				}
-			}
+// driverHighlights
+// Découpe les champs affichés d'un pilote pour le surlignage (le nom complet est réparti entre prénom et nom).
+func driverHighlights(driver models.Driver, spans map[string][]searchSpan) map[string][]models.HighlightSegment {
+	givenLength := len([]rune(driver.GivenName))
+	for _, span := range spans["fullName"] {
+		if span.start < givenLength {
+			spans["givenName"] = append(spans["givenName"], searchSpan{start: span.start, end: min(span.end, givenLength)})
 		}
+		if span.end > givenLength+1 {
+			spans["familyName"] = append(spans["familyName"], searchSpan{start: max(span.start-givenLength-1, 0), end: span.end - givenLength - 1})
+		}
+	}
+	return map[string][]models.HighlightSegment{
+		"givenName":       highlightSegments(driver.GivenName, spans["givenName"]),
+		"familyName":      highlightSegments(driver.FamilyName, spans["familyName"]),
+		"code":            highlightSegments(driver.Code, spans["code"]),
+		"permanentNumber": highlightSegments(driver.PermanentNumber, spans["permanentNumber"]),
+		"team":            highlightSegments(driver.Team, spans["team"]),
+		"nationality":     highlightSegments(driver.Nationality, spans["nationality"]),
+		"dateOfBirth":     highlightSegments(driver.DateOfBirth, spans["dateOfBirth"]),
 	}
+}
 
-	// Étape 6 : Retourner les résultats de la recherche.
-	return filteredDrivers, filteredConstructors
+// constructorHighlights
+// Découpe les champs affichés d'une écurie pour le surlignage.
+func constructorHighlights(constructor models.Constructor, spans map[string][]searchSpan) map[string][]models.HighlightSegment {
+	return map[string][]models.HighlightSegment{
+		"name":        highlightSegments(constructor.Name, spans["name"]),
+		"nationality": highlightSegments(constructor.Nationality, spans["nationality"]),
+	}
+}
+
+// searchResultItems
+// Retourne les fiches des résultats d'une recherche, dans l'ordre de pertinence.
+func searchResultItems(results models.SearchResults) ([]models.Driver, []models.Constructor) {
+	var drivers []models.Driver
+	var constructors []models.Constructor
+	for _, hit := range results.Drivers {
+		drivers = append(drivers, hit.Driver)
+	}
+	for _, hit := range results.Constructors {
+		constructors = append(constructors, hit.Constructor)
+	}
+	return drivers, constructors
 }
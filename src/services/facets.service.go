@@ -0,0 +1,163 @@
+package services
+
+import (
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Valeurs de la facette rookie.
+const (
+	RookieYes = "true"
+	RookieNo  = "false"
+)
+
+// Erreurs des filtres dérivés de la liste des pilotes.
+var (
+	ErrRookieUnavailable = errors.New("filtre rookie indisponible : aucune donnée pour la saison précédente")
+	ErrInvalidAgeBound   = errors.New("tranche d'âge invalide (ageMin et ageMax : entiers positifs)")
+	ErrInvalidRookie     = errors.New("filtre rookie invalide (valeurs acceptées : true, false)")
+	ErrInvalidDecade     = errors.New("décennie de naissance invalide (format : 1990s)")
+)
+
+// driverFacetContext
+// Données de la saison nécessaires aux filtres dérivés : date de calcul de l'âge et pilotes de la saison précédente
+// (nil si la saison précédente est indisponible).
+type driverFacetContext struct {
+	reference time.Time
+	previous  map[string]bool
+}
+
+// driverFacets liste les facettes des pilotes, dans l'ordre d'affichage (la facette rookie dépend de la saison précédente).
+func driverFacets(context driverFacetContext) []listFacet[models.Driver] {
+	facets := []listFacet[models.Driver]{
+		{name: "team", label: "Team", value: func(d models.Driver) string { return d.Team }},
+		{name: "nationality", label: "Nationality", value: func(d models.Driver) string { return d.Nationality }},
+		{name: "driverType", label: "Driver Type", value: func(d models.Driver) string { return d.DriverType }},
+		{name: "decade", label: "Birth Decade", value: birthDecade},
+	}
+	if context.previous == nil {
+		return facets
+	}
+	return append(facets, listFacet[models.Driver]{
+		name: "rookie", label: "Rookie",
+		value: func(d models.Driver) string { return rookieValue(d, context) },
+		valueLabel: func(value string) string {
+			if value == RookieYes {
+				return "Rookie"
+			}
+			return "Returning"
+		},
+	})
+}
+
+// NormalizeFilterValues
+// Nettoie les valeurs d'un filtre répété dans l'URL (?team=Ferrari&team=McLaren) : espaces retirés, valeurs vides et doublons ignorés.
+func NormalizeFilterValues(values []string) []string {
+	normalized := []string{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+	return normalized
+}
+
+// ParseAgeBound
+// Lit une borne d'âge (ageMin ou ageMax) : 0 si elle est absente, ErrInvalidAgeBound si ce n'est pas un entier positif.
+func ParseAgeBound(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	age, err := strconv.Atoi(value)
+	if err != nil || age < 0 {
+		return 0, ErrInvalidAgeBound
+	}
+	return age, nil
+}
+
+// validateDriverFilters
+// Vérifie les valeurs des filtres dérivés : rookie (true ou false) et décennie de naissance ("1990s").
+func validateDriverFilters(filters models.DriverFilters) error {
+	for _, value := range filters.Rookie {
+		if value != RookieYes && value != RookieNo {
+			return fmt.Errorf("%w : %q", ErrInvalidRookie, value)
+		}
+	}
+	for _, value := range filters.Decade {
+		year, ok := strings.CutSuffix(value, "s")
+		if number, err := strconv.Atoi(year); !ok || len(year) != 4 || err != nil || number < 1000 || number%10 != 0 {
+			return fmt.Errorf("%w : %q", ErrInvalidDecade, value)
+		}
+	}
+	return nil
+}
+
+// newDriverFacetContext
+// Prépare les filtres dérivés d'une saison : date de calcul de l'âge et pilotes de la saison précédente (index de la
+// saison précédente).
+func newDriverFacetContext(season string) driverFacetContext {
+	context := driverFacetContext{reference: ageReferenceDate(season)}
+	year, err := strconv.Atoi(season)
+	if err != nil {
+		return context
+	}
+	previous, err := seasonIndexFor(strconv.Itoa(year - 1))
+	if err != nil {
+		return context
+	}
+	context.previous = map[string]bool{}
+	for driverID := range previous.driversByID {
+		context.previous[driverID] = true
+	}
+	return context
+}
+
+// driverQuery
+// Prépare les filtres de la liste des pilotes pour le moteur de requête : facettes, valeurs sélectionnées et tranche d'âge.
+func driverQuery(filters models.DriverFilters, context driverFacetContext) listQuery[models.Driver] {
+	query := listQuery[models.Driver]{
+		facets: driverFacets(context),
+		selected: map[string][]string{
+			"team":        filters.Team,
+			"nationality": filters.Nationality,
+			"driverType":  filters.DriverType,
+			"decade":      filters.Decade,
+			"rookie":      filters.Rookie,
+		},
+	}
+	if filters.AgeMin > 0 || filters.AgeMax > 0 {
+		query.predicates = append(query.predicates, func(driver models.Driver) bool {
+			age, ok := driverAge(driver, context.reference)
+			return ok && (filters.AgeMin == 0 || age >= filters.AgeMin) && (filters.AgeMax == 0 || age <= filters.AgeMax)
+		})
+	}
+	return query
+}
+
+// birthDecade
+// Retourne la décennie de naissance d'un pilote ("1990s"), ou une chaîne vide si la date est inconnue.
+func birthDecade(driver models.Driver) string {
+	birth, ok := driverBirthDate(driver)
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(birth.Year()/10*10) + "s"
+}
+
+// rookieValue
+// Indique si un pilote est absent de la saison précédente (true), présent (false), ou "" si la saison précédente est inconnue.
+func rookieValue(driver models.Driver, context driverFacetContext) string {
+	if context.previous == nil {
+		return ""
+	}
+	if context.previous[driver.DriverID] {
+		return RookieNo
+	}
+	return RookieYes
+}
@@ -0,0 +1,604 @@
+package services
+
+import (
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultFavoritesCollectionID est l'identifiant de la collection par défaut (toujours présente, en première position).
+const DefaultFavoritesCollectionID = "default"
+
+// defaultFavoritesCollectionName est le nom initial de la collection par défaut (modifiable).
+const defaultFavoritesCollectionName = "Favorites"
+
+// Limites des champs saisis pour les collections et les favoris.
+const (
+	maxCollectionNameLength = 60
+	maxFavoriteNoteLength   = 500
+	maxFavoriteTags         = 10
+	maxFavoriteTagLength    = 30
+)
+
+// Regroupements proposés sur la page des favoris (paramètre group).
+const (
+	FavoritesGroupCollection = "collection"
+	FavoritesGroupType       = "type"
+	FavoritesGroupTag        = "tag"
+)
+
+// Tris proposés sur la page des favoris (paramètre sort).
+const (
+	FavoritesSortPosition = "position"
+	FavoritesSortAdded    = "added"
+	FavoritesSortName     = "name"
+)
+
+// Erreurs de gestion des collections et des favoris.
+var (
+	ErrCollectionNotFound    = errors.New("collection introuvable")
+	ErrCollectionNameTaken   = errors.New("une collection porte déjà ce nom")
+	ErrInvalidCollectionName = errors.New("nom de collection invalide (1 à 60 caractères)")
+	ErrDefaultCollection     = errors.New("la collection par défaut ne peut pas être supprimée")
+	ErrFavoriteNotFound      = errors.New("favori introuvable dans la collection")
+	ErrInvalidFavoriteNote   = errors.New("note trop longue (500 caractères maximum)")
+	ErrInvalidFavoriteTags   = errors.New("tags invalides (10 au maximum, 30 caractères chacun)")
+)
+
+// ResolveFavoritesGroup
+// Retourne le regroupement demandé, ou le regroupement par collection si la valeur est vide ou inconnue.
+func ResolveFavoritesGroup(group string) string {
+	switch group {
+	case FavoritesGroupType, FavoritesGroupTag:
+		return group
+	}
+	return FavoritesGroupCollection
+}
+
+// ResolveFavoritesSort
+// Retourne le tri demandé, ou l'ordre choisi par l'utilisateur si la valeur est vide ou inconnue.
+func ResolveFavoritesSort(sortParam string) string {
+	switch sortParam {
+	case FavoritesSortAdded, FavoritesSortName:
+		return sortParam
+	}
+	return FavoritesSortPosition
+}
+
+// CreateFavoritesCollection
+// -------------------------
+// Objectif :
+//   - Créer une collection vide, placée après les collections existantes.
+//   - Refuser un nom vide, trop long (400) ou déjà utilisé par une autre collection (409).
+//   - Retourner l'identifiant de la nouvelle collection.
+func CreateFavoritesCollection(owner, name string) (string, int, error) {
+	name, err := validateCollectionName(name)
+	if err != nil {
+		return "", http.StatusBadRequest, err
+	}
+	id := randomHex(6)
+	err = favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		if findCollectionByName(favorites, name) != nil {
+			return ErrCollectionNameTaken
+		}
+		favorites.Collections = append(favorites.Collections, models.FavoriteCollection{
+			ID:        id,
+			Name:      name,
+			CreatedAt: favoritesNow(),
+			Items:     []models.FavoriteItem{},
+		})
+		return nil
+	})
+	if err != nil {
+		return "", favoritesErrorStatus(err), err
+	}
+	return id, http.StatusCreated, nil
+}
+
+// RenameFavoritesCollection
+// Renomme une collection (la collection par défaut comprise).
+func RenameFavoritesCollection(owner, collectionID, name string) (int, error) {
+	name, err := validateCollectionName(name)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	err = favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		collection, err := findFavoritesCollection(favorites, collectionID)
+		if err != nil {
+			return err
+		}
+		if other := findCollectionByName(favorites, name); other != nil && other.ID != collection.ID {
+			return ErrCollectionNameTaken
+		}
+		collection.Name = name
+		return nil
+	})
+	return favoritesErrorStatus(err), err
+}
+
+// DeleteFavoritesCollection
+// Supprime une collection et ses éléments (les éléments présents dans d'autres collections restent favoris).
+func DeleteFavoritesCollection(owner, collectionID string) (int, error) {
+	if collectionID == "" || collectionID == DefaultFavoritesCollectionID {
+		return http.StatusBadRequest, ErrDefaultCollection
+	}
+	err := favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		for i, collection := range favorites.Collections {
+			if collection.ID == collectionID {
+				favorites.Collections = append(favorites.Collections[:i], favorites.Collections[i+1:]...)
+				return nil
+			}
+		}
+		return ErrCollectionNotFound
+	})
+	return favoritesErrorStatus(err), err
+}
+
+// MoveFavorite
+// ------------
+// Objectif :
+//   - Déplacer un élément à la position donnée dans sa collection (1 = en tête).
+//   - Ramener une position hors limites à la première ou à la dernière place.
+func MoveFavorite(owner, collectionID, kind, id string, position int) (int, error) {
+	err := favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		collection, err := findFavoritesCollection(favorites, collectionID)
+		if err != nil {
+			return err
+		}
+		index := favoriteItemIndex(collection.Items, kind, id)
+		if index < 0 {
+			return ErrFavoriteNotFound
+		}
+
+		item := collection.Items[index]
+		items := make([]models.FavoriteItem, 0, len(collection.Items))
+		items = append(items, collection.Items[:index]...)
+		items = append(items, collection.Items[index+1:]...)
+
+		target := min(max(position-1, 0), len(items))
+		moved := make([]models.FavoriteItem, 0, len(collection.Items))
+		moved = append(moved, items[:target]...)
+		moved = append(moved, item)
+		collection.Items = append(moved, items[target:]...)
+		return nil
+	})
+	return favoritesErrorStatus(err), err
+}
+
+// EditFavorite
+// ------------
+// Objectif :
+//   - Modifier la note libre et les tags d'un élément d'une collection.
+//   - Lire les tags séparés par des virgules (mis en minuscules, sans "#" ni doublons).
+func EditFavorite(owner, collectionID, kind, id, note, tags string) (int, error) {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > maxFavoriteNoteLength {
+		return http.StatusBadRequest, ErrInvalidFavoriteNote
+	}
+	parsedTags, err := parseFavoriteTags(tags)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	err = favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		collection, err := findFavoritesCollection(favorites, collectionID)
+		if err != nil {
+			return err
+		}
+		index := favoriteItemIndex(collection.Items, kind, id)
+		if index < 0 {
+			return ErrFavoriteNotFound
+		}
+		collection.Items[index].Note = note
+		collection.Items[index].Tags = parsedTags
+		return nil
+	})
+	return favoritesErrorStatus(err), err
+}
+
+// GetFavoritesPageService
+// -----------------------
+// Objectif :
+//   - Charger les favoris du propriétaire et retrouver les fiches des pilotes et écuries de la saison.
+//   - Regrouper les éléments par collection, par type ou par tag (FavoritesGroup*).
+//   - Trier chaque groupe selon l'ordre choisi par l'utilisateur, la date d'ajout ou le nom (FavoritesSort*).
+//   - Conserver les éléments absents de la saison (sans fiche) pour pouvoir les gérer, et marquer ceux qui
+//     n'existent plus dans aucune saison (Orphaned).
+func GetFavoritesPageService(owner, season, group, sortParam string) (*models.Favorites, []models.FavoriteGroup, int, error) {
+
+	// Étape 1 : Charger les favoris depuis le stockage.
+	favorites, err := LoadFavorites(owner)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, err
+	}
+
+	// Étape 2 : Récupérer l'index de la saison (pilotes et écuries par identifiant).
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, nil, sourceErrorStatus(err), err
+	}
+
+	// Étape 3 : Construire les éléments affichés de chaque collection.
+	entriesByCollection := make([][]models.FavoriteEntry, len(favorites.Collections))
+	missing := false
+	for i, collection := range favorites.Collections {
+		entries := make([]models.FavoriteEntry, 0, len(collection.Items))
+		for position, item := range collection.Items {
+			entry := models.FavoriteEntry{Item: item, CollectionID: collection.ID, Position: position + 1, Name: item.ID}
+			if item.Kind == FavoriteDriver {
+				if driver, ok := index.driversByID[item.ID]; ok {
+					entry.Driver = &driver
+					entry.Name = driver.GivenName + " " + driver.FamilyName
+				}
+			} else if constructor, ok := index.constructorsByID[item.ID]; ok {
+				entry.Constructor = &constructor
+				entry.Name = constructor.Name
+			}
+			missing = missing || (entry.Driver == nil && entry.Constructor == nil)
+			entries = append(entries, entry)
+		}
+		entriesByCollection[i] = entries
+	}
+
+	// Étape 4 : Marquer les éléments absents de toutes les saisons (non vérifiable si la source est indisponible).
+	if missing {
+		if known, err := knownFavoriteNames(); err == nil {
+			for _, entries := range entriesByCollection {
+				for j := range entries {
+					entries[j].Orphaned = known[entries[j].Item.Kind][entries[j].Item.ID] == ""
+				}
+			}
+		}
+	}
+
+	// Étape 5 : Regrouper puis trier les éléments.
+	groups := groupFavoriteEntries(favorites, entriesByCollection, ResolveFavoritesGroup(group))
+	for i := range groups {
+		sortFavoriteEntries(groups[i].Entries, ResolveFavoritesSort(sortParam))
+	}
+	return favorites, groups, http.StatusOK, nil
+}
+
+// groupFavoriteEntries
+// --------------------
+// Objectif :
+//   - Par collection : un groupe par collection, collections vides comprises.
+//   - Par type : pilotes puis écuries ; par tag : un groupe par tag (ordre alphabétique) puis les éléments sans tag.
+//   - Hors regroupement par collection, un élément présent dans plusieurs collections n'apparaît qu'une fois par groupe.
+func groupFavoriteEntries(favorites *models.Favorites, entriesByCollection [][]models.FavoriteEntry, group string) []models.FavoriteGroup {
+	if group == FavoritesGroupCollection {
+		groups := make([]models.FavoriteGroup, 0, len(favorites.Collections))
+		for i := range favorites.Collections {
+			groups = append(groups, models.FavoriteGroup{
+				Title:      favorites.Collections[i].Name,
+				Collection: &favorites.Collections[i],
+				Entries:    entriesByCollection[i],
+			})
+		}
+		return groups
+	}
+
+	// Répartir les éléments (une seule fois par groupe) selon leur type ou leurs tags.
+	titles := []string{}
+	entriesByTitle := map[string][]models.FavoriteEntry{}
+	seen := map[string]bool{}
+	addEntry := func(title string, entry models.FavoriteEntry) {
+		key := title + "\x00" + entry.Item.Kind + "\x00" + entry.Item.ID
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		if _, exists := entriesByTitle[title]; !exists {
+			titles = append(titles, title)
+		}
+		entriesByTitle[title] = append(entriesByTitle[title], entry)
+	}
+	for _, entries := range entriesByCollection {
+		for _, entry := range entries {
+			switch {
+			case group == FavoritesGroupType && entry.Item.Kind == FavoriteDriver:
+				addEntry("Drivers", entry)
+			case group == FavoritesGroupType:
+				addEntry("Teams", entry)
+			case len(entry.Item.Tags) == 0:
+				addEntry("", entry)
+			default:
+				for _, tag := range entry.Item.Tags {
+					addEntry("#"+tag, entry)
+				}
+			}
+		}
+	}
+
+	// Ordonner les groupes : type fixe, tags par ordre alphabétique et éléments sans tag en dernier.
+	sort.SliceStable(titles, func(i, j int) bool {
+		if group == FavoritesGroupType {
+			return titles[i] == "Drivers" && titles[j] != "Drivers"
+		}
+		if titles[i] == "" || titles[j] == "" {
+			return titles[j] == "" && titles[i] != ""
+		}
+		return titles[i] < titles[j]
+	})
+	groups := make([]models.FavoriteGroup, 0, len(titles))
+	for _, title := range titles {
+		groupTitle := title
+		if groupTitle == "" {
+			groupTitle = "Untagged"
+		}
+		groups = append(groups, models.FavoriteGroup{Title: groupTitle, Entries: entriesByTitle[title]})
+	}
+	return groups
+}
+
+// sortFavoriteEntries
+// Trie les éléments d'un groupe : ordre de l'utilisateur (inchangé), plus récents d'abord, ou par nom.
+func sortFavoriteEntries(entries []models.FavoriteEntry, sortParam string) {
+	switch sortParam {
+	case FavoritesSortAdded:
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].Item.AddedAt.After(entries[j].Item.AddedAt)
+		})
+	case FavoritesSortName:
+		sort.SliceStable(entries, func(i, j int) bool {
+			return strings.ToLower(entries[i].Name) < strings.ToLower(entries[j].Name)
+		})
+	}
+}
+
+// favoritesErrorStatus
+// Retourne le code HTTP correspondant à une erreur de modification des favoris (200 si err est nil).
+func favoritesErrorStatus(err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, ErrCollectionNotFound), errors.Is(err, ErrFavoriteNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrNoFavoritesHistory):
+		return http.StatusNotFound
+	case errors.Is(err, ErrCollectionNameTaken), errors.Is(err, ErrNothingToUndo):
+		return http.StatusConflict
+	case errors.Is(err, ErrInvalidFavoriteKind), errors.Is(err, ErrInvalidCollectionName), errors.Is(err, ErrDefaultCollection),
+		errors.Is(err, ErrInvalidFavoriteNote), errors.Is(err, ErrInvalidFavoriteTags), errors.Is(err, ErrNoFavoritesOwner):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// normalizeFavorites
+// ------------------
+// Objectif :
+//   - Convertir l'ancien format (listes drivers/constructors sans collections) en collection par défaut.
+//   - Garantir la présence de la collection par défaut en tête, sans collection en double.
+//   - Remplacer les identifiants d'écuries renommées (table d'alias), puis écarter les éléments invalides ou en double
+//     et initialiser les slices nil (pas de "null" dans les fichiers).
+//   - Déduire Drivers et Constructors des collections.
+func normalizeFavorites(favorites *models.Favorites) *models.Favorites {
+
+	// Étape 1 : Reprendre les listes de l'ancien format dans la collection par défaut.
+	if len(favorites.Collections) == 0 {
+		items := []models.FavoriteItem{}
+		for _, id := range favorites.Drivers {
+			items = append(items, models.FavoriteItem{Kind: FavoriteDriver, ID: id})
+		}
+		for _, id := range favorites.Constructors {
+			items = append(items, models.FavoriteItem{Kind: FavoriteConstructor, ID: id})
+		}
+		favorites.Collections = []models.FavoriteCollection{{ID: DefaultFavoritesCollectionID, CreatedAt: favoritesNow(), Items: items}}
+	}
+
+	// Étape 2 : Placer la collection par défaut en tête (la créer si besoin) et écarter les doublons.
+	var defaultCollection *models.FavoriteCollection
+	others := []models.FavoriteCollection{}
+	seen := map[string]bool{}
+	for i := range favorites.Collections {
+		collection := favorites.Collections[i]
+		if collection.ID == "" || seen[collection.ID] {
+			continue
+		}
+		seen[collection.ID] = true
+		if collection.ID == DefaultFavoritesCollectionID {
+			defaultCollection = &collection
+			continue
+		}
+		others = append(others, collection)
+	}
+	if defaultCollection == nil {
+		defaultCollection = &models.FavoriteCollection{ID: DefaultFavoritesCollectionID, CreatedAt: favoritesNow()}
+	}
+	favorites.Collections = append([]models.FavoriteCollection{*defaultCollection}, others...)
+
+	// Étape 3 : Nettoyer les éléments et déduire les identifiants favoris par type.
+	favorites.Drivers = []string{}
+	favorites.Constructors = []string{}
+	for i := range favorites.Collections {
+		collection := &favorites.Collections[i]
+		if collection.Name == "" {
+			collection.Name = defaultFavoritesCollectionName
+			if collection.ID != DefaultFavoritesCollectionID {
+				collection.Name = collection.ID
+			}
+		}
+		items := []models.FavoriteItem{}
+		for _, item := range collection.Items {
+			migrateFavoriteItem(&item)
+			if item.ID == "" || validateFavoriteKind(item.Kind) != nil || favoriteItemIndex(items, item.Kind, item.ID) >= 0 {
+				continue
+			}
+			if item.Tags == nil {
+				item.Tags = []string{}
+			}
+			items = append(items, item)
+
+			ids := &favorites.Drivers
+			if item.Kind == FavoriteConstructor {
+				ids = &favorites.Constructors
+			}
+			if !containsID(*ids, item.ID) {
+				*ids = append(*ids, item.ID)
+			}
+		}
+		collection.Items = items
+	}
+	return favorites
+}
+
+// addFavoriteItem
+// Ajoute un élément en fin de collection s'il n'y est pas déjà (collection vide : collection par défaut).
+func addFavoriteItem(favorites *models.Favorites, collectionID, kind, id string) error {
+	if err := validateFavoriteKind(kind); err != nil {
+		return err
+	}
+	collection, err := findFavoritesCollection(favorites, collectionID)
+	if err != nil {
+		return err
+	}
+	if favoriteItemIndex(collection.Items, kind, id) < 0 {
+		collection.Items = append(collection.Items, models.FavoriteItem{Kind: kind, ID: id, Tags: []string{}, AddedAt: favoritesNow()})
+	}
+	return nil
+}
+
+// removeFavoriteItem
+// Retire un élément d'une collection, ou de toutes les collections si collectionID est vide.
+func removeFavoriteItem(favorites *models.Favorites, collectionID, kind, id string) error {
+	if err := validateFavoriteKind(kind); err != nil {
+		return err
+	}
+	for i := range favorites.Collections {
+		collection := &favorites.Collections[i]
+		if collectionID != "" && collection.ID != collectionID {
+			continue
+		}
+		if index := favoriteItemIndex(collection.Items, kind, id); index >= 0 {
+			collection.Items = append(collection.Items[:index:index], collection.Items[index+1:]...)
+		}
+		if collectionID != "" {
+			return nil
+		}
+	}
+	if collectionID != "" {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+// mergeFavoriteCollections
+// ------------------------
+// Objectif :
+//   - Ajouter les éléments de source à target, sans doublon, en conservant leurs notes, tags et dates d'ajout.
+//   - Rapprocher les collections par nom (la collection par défaut avec la collection par défaut).
+//   - Créer dans target les collections de source qui n'y existent pas.
+func mergeFavoriteCollections(target, source *models.Favorites) {
+	for _, sourceCollection := range normalizeFavorites(source).Collections {
+		var collection *models.FavoriteCollection
+		if sourceCollection.ID == DefaultFavoritesCollectionID {
+			collection, _ = findFavoritesCollection(target, DefaultFavoritesCollectionID)
+		} else {
+			collection = findCollectionByName(target, sourceCollection.Name)
+		}
+		if collection == nil {
+			id := sourceCollection.ID
+			if existing, _ := findFavoritesCollection(target, id); existing != nil {
+				id = randomHex(6)
+			}
+			target.Collections = append(target.Collections, models.FavoriteCollection{
+				ID:        id,
+				Name:      sourceCollection.Name,
+				CreatedAt: sourceCollection.CreatedAt,
+				Items:     []models.FavoriteItem{},
+			})
+			collection = &target.Collections[len(target.Collections)-1]
+		}
+		for _, item := range sourceCollection.Items {
+			if favoriteItemIndex(collection.Items, item.Kind, item.ID) < 0 {
+				collection.Items = append(collection.Items, item)
+			}
+		}
+	}
+}
+
+// findFavoritesCollection
+// Retourne la collection d'identifiant donné (vide : collection par défaut), ErrCollectionNotFound sinon.
+func findFavoritesCollection(favorites *models.Favorites, collectionID string) (*models.FavoriteCollection, error) {
+	if collectionID == "" {
+		collectionID = DefaultFavoritesCollectionID
+	}
+	for i := range favorites.Collections {
+		if favorites.Collections[i].ID == collectionID {
+			return &favorites.Collections[i], nil
+		}
+	}
+	return nil, ErrCollectionNotFound
+}
+
+// findCollectionByName
+// Retourne la collection portant ce nom, sans tenir compte de la casse (nil si aucune).
+func findCollectionByName(favorites *models.Favorites, name string) *models.FavoriteCollection {
+	for i := range favorites.Collections {
+		if strings.EqualFold(favorites.Collections[i].Name, name) {
+			return &favorites.Collections[i]
+		}
+	}
+	return nil
+}
+
+// favoriteItemIndex
+// Retourne l'index d'un élément dans une liste (-1 s'il n'y est pas).
+func favoriteItemIndex(items []models.FavoriteItem, kind, id string) int {
+	for i, item := range items {
+		if item.Kind == kind && item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateFavoriteKind
+// Vérifie qu'un type d'élément est un pilote ou une écurie.
+func validateFavoriteKind(kind string) error {
+	if kind != FavoriteDriver && kind != FavoriteConstructor {
+		return fmt.Errorf("%w : %q", ErrInvalidFavoriteKind, kind)
+	}
+	return nil
+}
+
+// validateCollectionName
+// Retourne le nom de collection sans espaces autour, ou ErrInvalidCollectionName s'il est vide ou trop long.
+func validateCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCollectionNameLength {
+		return "", ErrInvalidCollectionName
+	}
+	return name, nil
+}
+
+// parseFavoriteTags
+// Découpe une saisie "rookie, #fantasy" en tags en minuscules, sans doublons (ErrInvalidFavoriteTags si trop nombreux ou trop longs).
+func parseFavoriteTags(input string) ([]string, error) {
+	tags := []string{}
+	for _, raw := range strings.Split(input, ",") {
+		tag := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(raw), "#")))
+		if tag == "" || containsID(tags, tag) {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxFavoriteTagLength {
+			return nil, ErrInvalidFavoriteTags
+		}
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxFavoriteTags {
+		return nil, ErrInvalidFavoriteTags
+	}
+	return tags, nil
+}
+
+// favoritesNow
+// Retourne la date courante (UTC, à la seconde) enregistrée lors d'un ajout.
+func favoritesNow() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
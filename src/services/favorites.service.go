@@ -1,9 +1,8 @@
 package services
 
 import (
-	"encoding/json"
 	"f1-app/models"
-	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 )
@@ -28,153 +27,55 @@ func GetFavoritesFilePath() string {
 }
 
 // LoadFavorites
-// Charge les favoris depuis le fichier JSON. Crée le fichier s'il n'existe pas encore.
-func LoadFavorites() (*models.Favorites, error) {
-	filePath := GetFavoritesFilePath()
-
-	// Vérifier si le fichier existe, sinon le créer.
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		favorites := &models.Favorites{
-			Drivers:      []string{},
-			Constructors: []string{},
-		}
-		// Créer le fichier avec la structure vide.
-		if err := SaveFavorites(favorites); err != nil {
-			return nil, err
-		}
-		return favorites, nil
-	}
-
-	// Lire le fichier des favoris.
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("erreur lecture fichier favoris: %w", err)
-	}
-
-	var favorites models.Favorites
-	if err := json.Unmarshal(data, &favorites); err != nil {
-		return nil, fmt.Errorf("erreur décodage JSON favoris: %w", err)
-	}
-
-	// Initialiser les slices s'ils sont nil pour éviter les modifications nulles.
-	if favorites.Drivers == nil {
-		favorites.Drivers = []string{}
-	}
-	if favorites.Constructors == nil {
-		favorites.Constructors = []string{}
-	}
-
-	return &favorites, nil
+// Charge les favoris d'un propriétaire depuis le stockage configuré (vides s'il n'en a pas).
+func LoadFavorites(owner string) (*models.Favorites, error) {
+	return favoritesStore.Load(owner)
 }
 
 // SaveFavorites
-// Sauvegarde les favoris dans le fichier JSON.
-func SaveFavorites(favorites *models.Favorites) error {
-	filePath := GetFavoritesFilePath()
-
-	data, err := json.MarshalIndent(favorites, "", "    ")
-	if err != nil {
-		return fmt.Errorf("erreur encodage JSON favoris: %w", err)
-	}
-
-	if err := os.WriteFile(filePath, data, 0644); err != nil {
-		return fmt.Errorf("erreur écriture fichier favoris: %w", err)
-	}
+// Remplace les favoris d'un propriétaire dans le stockage configuré.
+func SaveFavorites(owner string, favorites *models.Favorites) error {
+	return favoritesStore.Save(owner, favorites)
+}
 
-	return nil
+// UpdateFavorites
+// Applique une modification aux favoris d'un propriétaire sans qu'une autre écriture puisse s'intercaler.
+func UpdateFavorites(owner string, fn func(favorites *models.Favorites) error) error {
+	return favoritesStore.Update(owner, fn)
 }
 
 // AddDriverToFavorites
-// Ajoute un pilote aux favoris s'il n'y est pas déjà.
-func AddDriverToFavorites(driverID string) error {
-	favorites, err := LoadFavorites()
-	if err != nil {
-		return err
-	}
-
-	// Vérifier si le pilote n'est pas déjà dans les favoris.
-	for _, id := range favorites.Drivers {
-		if id == driverID {
-			return nil
-		}
-	}
-
-	favorites.Drivers = append(favorites.Drivers, driverID)
-	return SaveFavorites(favorites)
+// Ajoute un pilote aux favoris d'un propriétaire s'il n'y est pas déjà.
+func AddDriverToFavorites(owner, driverID string) error {
+	return favoritesStore.Add(owner, FavoriteDriver, driverID)
 }
 
 // RemoveDriverFromFavorites
-// Supprime un pilote des favoris.
-func RemoveDriverFromFavorites(driverID string) error {
-	favorites, err := LoadFavorites()
-	if err != nil {
-		return err
-	}
-
-	// Reconstruire la liste sans le pilote à supprimer.
-	newDrivers := []string{}
-	for _, id := range favorites.Drivers {
-		if id != driverID {
-			newDrivers = append(newDrivers, id)
-		}
-	}
-
-	favorites.Drivers = newDrivers
-	return SaveFavorites(favorites)
+// Supprime un pilote des favoris d'un propriétaire.
+func RemoveDriverFromFavorites(owner, driverID string) error {
+	return favoritesStore.Remove(owner, FavoriteDriver, driverID)
 }
 
 // AddConstructorToFavorites
-// Ajoute une écurie aux favoris si elle n'y est pas déjà.
-func AddConstructorToFavorites(constructorID string) error {
-	favorites, err := LoadFavorites()
-	if err != nil {
-		return err
-	}
-
-	// Vérifier si l'écurie n'est pas déjà dans les favoris.
-	for _, id := range favorites.Constructors {
-		if id == constructorID {
-			return nil
-		}
-	}
-
-	favorites.Constructors = append(favorites.Constructors, constructorID)
-	return SaveFavorites(favorites)
+// Ajoute une écurie aux favoris d'un propriétaire si elle n'y est pas déjà.
+func AddConstructorToFavorites(owner, constructorID string) error {
+	return favoritesStore.Add(owner, FavoriteConstructor, constructorID)
 }
 
 // RemoveConstructorFromFavorites
-// -----------
-// Objectif :
-//   - Supprimer une écurie spécifique de la liste des favoris.
-//   - Sauvegarder la liste mise à jour.
-func RemoveConstructorFromFavorites(constructorID string) error {
-	// Étape 1 : Charger les favoris actuels depuis le fichier.
-	favorites, err := LoadFavorites()
-	if err != nil {
-		return err
-	}
-
-	// Étape 2 : Reconstruire la liste sans l'écurie à supprimer.
-	newConstructors := []string{}
-	for _, id := range favorites.Constructors {
-		if id != constructorID {
-			newConstructors = append(newConstructors, id)
-		}
-	}
-
-	// Étape 3 : Mettre à jour la liste et sauvegarder.
-	favorites.Constructors = newConstructors
-	return SaveFavorites(favorites)
+// Supprime une écurie des favoris d'un propriétaire.
+func RemoveConstructorFromFavorites(owner, constructorID string) error {
+	return favoritesStore.Remove(owner, FavoriteConstructor, constructorID)
 }
 
 // IsDriverFavorite
 // -----------
 // Objectif :
-//   - Vérifier si un pilote spécifique est dans les favoris.
+//   - Vérifier si un pilote spécifique est dans les favoris d'un propriétaire.
 //   - Retourner un booléen indiquant la présence du pilote.
-func IsDriverFavorite(driverID string) bool {
-	// Étape 1 : Charger les favoris depuis le fichier.
-	favorites, err := LoadFavorites()
+func IsDriverFavorite(owner, driverID string) bool {
+	// Étape 1 : Charger les favoris depuis le stockage.
+	favorites, err := LoadFavorites(owner)
 	if err != nil {
 		return false
 	}
@@ -193,11 +94,11 @@ func IsDriverFavorite(driverID string) bool {
 // IsConstructorFavorite
 // -----------
 // Objectif :
-//   - Vérifier si une écurie spécifique est dans les favoris.
+//   - Vérifier si une écurie spécifique est dans les favoris d'un propriétaire.
 //   - Retourner un booléen indiquant la présence de l'écurie.
-func IsConstructorFavorite(constructorID string) bool {
-	// Étape 1 : Charger les favoris depuis le fichier.
-	favorites, err := LoadFavorites()
+func IsConstructorFavorite(owner, constructorID string) bool {
+	// Étape 1 : Charger les favoris depuis le stockage.
+	favorites, err := LoadFavorites(owner)
 	if err != nil {
 		return false
 	}
@@ -212,3 +113,42 @@ func IsConstructorFavorite(constructorID string) bool {
 	// Étape 4 : Retourner faux si l'écurie n'existe pas.
 	return false
 }
+
+// GetFavoritesService
+// -------------------
+// Objectif :
+//   - Charger les favoris enregistrés du propriétaire (compte connecté ou visiteur anonyme).
+//   - Retrouver les fiches des pilotes et écuries favoris pour la saison demandée.
+//   - Ignorer les identifiants absents de la saison (ils restent dans la liste enregistrée).
+func GetFavoritesService(owner, season string) (*models.Favorites, []models.Driver, []models.Constructor, int, error) {
+
+	// Étape 1 : Charger les favoris depuis le stockage.
+	favorites, err := LoadFavorites(owner)
+	if err != nil {
+		return nil, nil, nil, http.StatusInternalServerError, err
+	}
+
+	// Étape 2 : Récupérer l'index de la saison (pilotes et écuries par identifiant).
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, nil, nil, sourceErrorStatus(err), err
+	}
+
+	// Étape 3 : Retrouver les pilotes favoris.
+	favoriteDrivers := make([]models.Driver, 0, len(favorites.Drivers))
+	for _, driverID := range favorites.Drivers {
+		if driver, exists := index.driversByID[driverID]; exists {
+			favoriteDrivers = append(favoriteDrivers, driver)
+		}
+	}
+
+	// Étape 4 : Retrouver les écuries favorites.
+	favoriteConstructors := make([]models.Constructor, 0, len(favorites.Constructors))
+	for _, constructorID := range favorites.Constructors {
+		if constructor, exists := index.constructorsByID[constructorID]; exists {
+			favoriteConstructors = append(favoriteConstructors, constructor)
+		}
+	}
+
+	return favorites, favoriteDrivers, favoriteConstructors, http.StatusOK, nil
+}
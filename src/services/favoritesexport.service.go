@@ -0,0 +1,371 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FavoritesExportVersion est la version du format d'export JSON (un fichier plus récent est refusé à l'import).
+const FavoritesExportVersion = 1
+
+// MaxFavoritesImportSize est la taille maximale d'un fichier importé (1 Mo).
+const MaxFavoritesImportSize = 1 << 20
+
+// Formats d'export des favoris.
+const (
+	FavoritesFormatJSON = "json"
+	FavoritesFormatCSV  = "csv"
+)
+
+// Modes d'import : fusion avec les favoris existants ou remplacement complet.
+const (
+	FavoritesImportMerge   = "merge"
+	FavoritesImportReplace = "replace"
+)
+
+// maxImportProblems est le nombre de problèmes détaillés dans le message d'un import refusé.
+const maxImportProblems = 5
+
+// favoritesCSVHeader
+// Colonnes d'un export CSV : une ligne par élément, dans l'ordre des collections puis de leurs éléments.
+var favoritesCSVHeader = []string{"collection_id", "collection", "kind", "id", "note", "tags", "added_at"}
+
+// Erreurs de l'export et de l'import des favoris.
+var (
+	ErrInvalidExportFormat     = errors.New("format d'export invalide (json ou csv)")
+	ErrInvalidImportMode       = errors.New("mode d'import invalide (merge ou replace)")
+	ErrInvalidFavoritesImport  = errors.New("fichier de favoris invalide")
+	ErrUnsupportedImportFormat = errors.New("version du fichier de favoris non prise en charge")
+)
+
+// ExportFavorites
+// ---------------
+// Objectif :
+//   - Exporter les collections d'un propriétaire en JSON (format complet, relu par l'import) ou en CSV (une ligne par élément).
+//   - Retourner le contenu et son type MIME, ou 400 pour un format inconnu.
+func ExportFavorites(owner, format string) ([]byte, string, int, error) {
+	if format == "" {
+		format = FavoritesFormatJSON
+	}
+	if format != FavoritesFormatJSON && format != FavoritesFormatCSV {
+		return nil, "", http.StatusBadRequest, ErrInvalidExportFormat
+	}
+
+	// Étape 1 : Charger les favoris.
+	favorites, err := favoritesStore.Load(owner)
+	if err != nil {
+		return nil, "", http.StatusInternalServerError, err
+	}
+
+	// Étape 2 : Encoder au format demandé.
+	if format == FavoritesFormatCSV {
+		data, err := encodeFavoritesCSV(favorites)
+		if err != nil {
+			return nil, "", http.StatusInternalServerError, err
+		}
+		return data, "text/csv; charset=utf-8", http.StatusOK, nil
+	}
+	data, err := json.MarshalIndent(models.FavoritesExport{
+		Version:     FavoritesExportVersion,
+		ExportedAt:  favoritesNow(),
+		Collections: favorites.Collections,
+	}, "", "    ")
+	if err != nil {
+		return nil, "", http.StatusInternalServerError, fmt.Errorf("erreur encodage JSON favoris: %w", err)
+	}
+	return data, "application/json", http.StatusOK, nil
+}
+
+// ImportFavorites
+// ---------------
+// Objectif :
+//   - Lire un fichier exporté (JSON ou CSV, détecté d'après son contenu) ou l'ancien format {"drivers", "constructors"}.
+//   - Valider types, noms de collections, notes, tags, dates, et les identifiants face aux pilotes et écuries connus
+//     (toutes saisons confondues) : un seul problème refuse tout l'import (400, problèmes détaillés dans le message).
+//   - Fusionner avec les favoris existants (mode merge : collections rapprochées par nom, doublons ignorés)
+//     ou les remplacer (mode replace).
+//   - Retourner le nombre d'éléments importés.
+func ImportFavorites(owner string, data []byte, mode string) (int, int, error) {
+	if mode == "" {
+		mode = FavoritesImportMerge
+	}
+	if mode != FavoritesImportMerge && mode != FavoritesImportReplace {
+		return 0, http.StatusBadRequest, ErrInvalidImportMode
+	}
+
+	// Étape 1 : Décoder le fichier.
+	imported, err := decodeFavoritesImport(data)
+	if err != nil {
+		return 0, http.StatusBadRequest, err
+	}
+
+	// Étape 2 : Valider son contenu.
+	known, err := knownFavoriteNames()
+	if err != nil {
+		return 0, http.StatusBadGateway, err
+	}
+	count, problems := validateFavoritesImport(imported, known)
+	if len(problems) > 0 {
+		return 0, http.StatusBadRequest, importProblemsError(problems)
+	}
+
+	// Étape 3 : Fusionner ou remplacer les favoris du propriétaire.
+	err = favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		if mode == FavoritesImportReplace {
+			*favorites = *imported
+			return nil
+		}
+		mergeFavoriteCollections(favorites, imported)
+		return nil
+	})
+	if err != nil {
+		return 0, http.StatusInternalServerError, err
+	}
+	return count, http.StatusOK, nil
+}
+
+// encodeFavoritesCSV
+// Encode les collections en CSV (en-tête favoritesCSVHeader, tags séparés par des virgules, dates RFC 3339).
+func encodeFavoritesCSV(favorites *models.Favorites) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(favoritesCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, collection := range favorites.Collections {
+		for _, item := range collection.Items {
+			record := []string{
+				collection.ID, collection.Name, item.Kind, item.ID, item.Note,
+				strings.Join(item.Tags, ", "), formatFavoritesTime(item.AddedAt),
+			}
+			if err := writer.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("erreur encodage CSV favoris: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeFavoritesImport
+// Décode un fichier importé : JSON s'il commence par "{", CSV sinon.
+func decodeFavoritesImport(data []byte) (*models.Favorites, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("%w : fichier vide", ErrInvalidFavoritesImport)
+	}
+	if trimmed[0] == '{' {
+		return decodeFavoritesJSON(trimmed)
+	}
+	return decodeFavoritesCSV(data)
+}
+
+// decodeFavoritesJSON
+// Décode un export JSON, des favoris complets ({"collections": [...]}) ou l'ancien format {"drivers", "constructors"}.
+func decodeFavoritesJSON(data []byte) (*models.Favorites, error) {
+	var content struct {
+		Version      int                         `json:"version"`
+		Collections  []models.FavoriteCollection `json:"collections"`
+		Drivers      []string                    `json:"drivers"`
+		Constructors []string                    `json:"constructors"`
+	}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("%w : %v", ErrInvalidFavoritesImport, err)
+	}
+	if content.Version > FavoritesExportVersion {
+		return nil, fmt.Errorf("%w (version %d)", ErrUnsupportedImportFormat, content.Version)
+	}
+
+	if len(content.Collections) == 0 {
+		items := []models.FavoriteItem{}
+		for _, id := range content.Drivers {
+			items = append(items, models.FavoriteItem{Kind: FavoriteDriver, ID: id})
+		}
+		for _, id := range content.Constructors {
+			items = append(items, models.FavoriteItem{Kind: FavoriteConstructor, ID: id})
+		}
+		content.Collections = []models.FavoriteCollection{{ID: DefaultFavoritesCollectionID, Items: items}}
+	}
+	return &models.Favorites{Collections: content.Collections}, nil
+}
+
+// decodeFavoritesCSV
+// ------------------
+// Objectif :
+//   - Lire un export CSV : colonnes repérées par leur nom (kind et id obligatoires, les autres facultatives).
+//   - Regrouper les lignes par collection (collection_id, sinon nom ; aucune : collection par défaut),
+//     dans l'ordre de leur première apparition.
+func decodeFavoritesCSV(data []byte) (*models.Favorites, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	// Étape 1 : Lire l'en-tête.
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w : en-tête CSV illisible", ErrInvalidFavoritesImport)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["kind"]; !ok {
+		return nil, fmt.Errorf("%w : colonnes kind et id requises", ErrInvalidFavoritesImport)
+	}
+	if _, ok := columns["id"]; !ok {
+		return nil, fmt.Errorf("%w : colonnes kind et id requises", ErrInvalidFavoritesImport)
+	}
+
+	// Étape 2 : Lire les lignes et les ranger par collection.
+	favorites := &models.Favorites{Collections: []models.FavoriteCollection{}}
+	indexByKey := map[string]int{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w : ligne %d illisible", ErrInvalidFavoritesImport, line)
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		collectionID, collectionName := field("collection_id"), field("collection")
+		key := "id:" + collectionID
+		if collectionID == "" {
+			key = "name:" + strings.ToLower(collectionName)
+			collectionID = DefaultFavoritesCollectionID
+			if collectionName != "" {
+				collectionID = randomHex(6)
+			}
+		}
+		index, exists := indexByKey[key]
+		if !exists {
+			index = len(favorites.Collections)
+			indexByKey[key] = index
+			favorites.Collections = append(favorites.Collections, models.FavoriteCollection{ID: collectionID, Name: collectionName})
+		}
+
+		item := models.FavoriteItem{Kind: field("kind"), ID: field("id"), Note: field("note")}
+		if tags := field("tags"); tags != "" {
+			item.Tags = strings.Split(tags, ",")
+		}
+		if addedAt := field("added_at"); addedAt != "" {
+			item.AddedAt, err = time.Parse(time.RFC3339, addedAt)
+			if err != nil {
+				return nil, fmt.Errorf("%w : ligne %d, date invalide %q", ErrInvalidFavoritesImport, line, addedAt)
+			}
+		}
+		favorites.Collections[index].Items = append(favorites.Collections[index].Items, item)
+	}
+	return favorites, nil
+}
+
+// validateFavoritesImport
+// -----------------------
+// Objectif :
+//   - Vérifier chaque collection (nom valide et unique) et chaque élément (type, identifiant connu après application
+//     des alias d'écuries renommées, note, tags).
+//   - Normaliser au passage noms, tags et dates (date d'import pour un élément sans date) ; donner un nouvel
+//     identifiant aux collections autres que la collection par défaut.
+//   - Retourner le nombre d'éléments et la liste des problèmes rencontrés.
+func validateFavoritesImport(imported *models.Favorites, known map[string]map[string]string) (int, []string) {
+	problems := []string{}
+	names := map[string]bool{}
+	count := 0
+	now := favoritesNow()
+
+	for i := range imported.Collections {
+		collection := &imported.Collections[i]
+
+		// Étape 1 : Valider la collection.
+		if collection.ID != DefaultFavoritesCollectionID {
+			collection.ID = randomHex(6)
+		}
+		if collection.ID == DefaultFavoritesCollectionID && strings.TrimSpace(collection.Name) == "" {
+			collection.Name = defaultFavoritesCollectionName
+		}
+		name, err := validateCollectionName(collection.Name)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("collection %q : %v", collection.Name, err))
+			continue
+		}
+		if names[strings.ToLower(name)] {
+			problems = append(problems, fmt.Sprintf("collection %q : %v", name, ErrCollectionNameTaken))
+		}
+		names[strings.ToLower(name)] = true
+		collection.Name = name
+		if collection.CreatedAt.IsZero() {
+			collection.CreatedAt = now
+		}
+
+		// Étape 2 : Valider ses éléments.
+		for j := range collection.Items {
+			item := &collection.Items[j]
+			item.ID = strings.TrimSpace(item.ID)
+			if err := validateFavoriteKind(item.Kind); err != nil {
+				problems = append(problems, fmt.Sprintf("%q : %v (%q)", item.ID, err, item.Kind))
+				continue
+			}
+			migrateFavoriteItem(item)
+			if known[item.Kind][item.ID] == "" {
+				problems = append(problems, fmt.Sprintf("%s : %q", unknownFavoriteLabel(item.Kind), item.ID))
+				continue
+			}
+			item.Note = strings.TrimSpace(item.Note)
+			if utf8.RuneCountInString(item.Note) > maxFavoriteNoteLength {
+				problems = append(problems, fmt.Sprintf("%q : %v", item.ID, ErrInvalidFavoriteNote))
+			}
+			tags, err := parseFavoriteTags(strings.Join(item.Tags, ","))
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%q : %v", item.ID, err))
+			}
+			item.Tags = tags
+			if item.AddedAt.IsZero() {
+				item.AddedAt = now
+			}
+			item.AddedAt = item.AddedAt.UTC()
+			count++
+		}
+	}
+	return count, problems
+}
+
+// unknownFavoriteLabel
+// Retourne le libellé d'un identifiant inconnu selon le type de favori (messages d'erreur).
+func unknownFavoriteLabel(kind string) string {
+	if kind == FavoriteConstructor {
+		return "écurie inconnue"
+	}
+	return "pilote inconnu"
+}
+
+// importProblemsError
+// Construit l'erreur d'un import refusé en citant les premiers problèmes rencontrés.
+func importProblemsError(problems []string) error {
+	listed := problems
+	if len(listed) > maxImportProblems {
+		listed = listed[:maxImportProblems]
+	}
+	message := strings.Join(listed, " ; ")
+	if len(problems) > len(listed) {
+		message += fmt.Sprintf(" ; … (%d problèmes au total)", len(problems))
+	}
+	return fmt.Errorf("%w : %s", ErrInvalidFavoritesImport, message)
+}
@@ -0,0 +1,374 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"f1-app/models"
+	"net/http"
+	"time"
+)
+
+// Actions du journal des favoris.
+const (
+	FavoriteEventAdd      = "add"
+	FavoriteEventRemove   = "remove"
+	FavoriteEventSnapshot = "snapshot"
+)
+
+// Erreurs de l'historique des favoris.
+var (
+	ErrNothingToUndo      = errors.New("aucune modification à annuler")
+	ErrNoFavoritesHistory = errors.New("aucun historique pour ces favoris")
+)
+
+// AddFavoriteToCollection
+// -----------------------
+// Objectif :
+//   - Ajouter un pilote ou une écurie en fin de collection s'il n'y est pas déjà (collection vide : collection par défaut).
+//   - Enregistrer l'ajout dans le journal au nom de actor (nom du compte, vide pour un visiteur anonyme).
+func AddFavoriteToCollection(owner, actor, collectionID, kind, id string) (int, error) {
+	err := recordFavoriteChange(owner, actor, func(favorites *models.Favorites, _ []models.FavoriteEvent, event *models.FavoriteEvent) (bool, error) {
+		if err := validateFavoriteKind(kind); err != nil {
+			return false, err
+		}
+		collection, err := findFavoritesCollection(favorites, collectionID)
+		if err != nil {
+			return false, err
+		}
+		if favoriteItemIndex(collection.Items, kind, id) >= 0 {
+			return false, nil
+		}
+		if err := addFavoriteItem(favorites, collection.ID, kind, id); err != nil {
+			return false, err
+		}
+
+		event.Action, event.Kind, event.ItemID = FavoriteEventAdd, kind, id
+		event.Placements = []models.FavoritePlacement{{
+			Collection:     collection.ID,
+			CollectionName: collection.Name,
+			Position:       len(collection.Items),
+			Item:           collection.Items[len(collection.Items)-1],
+		}}
+		return true, nil
+	})
+	return favoritesErrorStatus(err), err
+}
+
+// RemoveFavoriteFromCollection
+// ----------------------------
+// Objectif :
+//   - Retirer un pilote ou une écurie d'une collection (collection vide : de toutes les collections).
+//   - Enregistrer dans le journal chaque emplacement retiré (position, note, tags) pour pouvoir l'annuler.
+func RemoveFavoriteFromCollection(owner, actor, collectionID, kind, id string) (int, error) {
+	err := recordFavoriteChange(owner, actor, func(favorites *models.Favorites, _ []models.FavoriteEvent, event *models.FavoriteEvent) (bool, error) {
+		if err := validateFavoriteKind(kind); err != nil {
+			return false, err
+		}
+		placements := []models.FavoritePlacement{}
+		for _, collection := range favorites.Collections {
+			if collectionID != "" && collection.ID != collectionID {
+				continue
+			}
+			if index := favoriteItemIndex(collection.Items, kind, id); index >= 0 {
+				placements = append(placements, models.FavoritePlacement{
+					Collection:     collection.ID,
+					CollectionName: collection.Name,
+					Position:       index + 1,
+					Item:           collection.Items[index],
+				})
+			}
+		}
+		if err := removeFavoriteItem(favorites, collectionID, kind, id); err != nil || len(placements) == 0 {
+			return false, err
+		}
+
+		event.Action, event.Kind, event.ItemID = FavoriteEventRemove, kind, id
+		event.Placements = placements
+		return true, nil
+	})
+	return favoritesErrorStatus(err), err
+}
+
+// UndoLastFavoriteChange
+// ----------------------
+// Objectif :
+//   - Annuler le dernier ajout ou retrait non encore annulé (des annulations successives remontent l'historique).
+//   - Remettre un élément retiré à sa place, avec sa note et ses tags (collection recréée si elle a été supprimée),
+//     ou retirer un élément ajouté.
+//   - Enregistrer l'annulation dans le journal (événement inverse) et retourner l'événement annulé (409 s'il n'y en a pas).
+func UndoLastFavoriteChange(owner, actor string) (*models.FavoriteEvent, int, error) {
+	var undone models.FavoriteEvent
+	err := recordFavoriteChange(owner, actor, func(favorites *models.Favorites, history []models.FavoriteEvent, event *models.FavoriteEvent) (bool, error) {
+		target := lastUndoableEvent(history)
+		if target == nil {
+			return false, ErrNothingToUndo
+		}
+		undone = *target
+		revertFavoriteEvent(favorites, target, event)
+		return true, nil
+	})
+	if err != nil {
+		return nil, favoritesErrorStatus(err), err
+	}
+	return &undone, http.StatusOK, nil
+}
+
+// RebuildFavorites
+// ----------------
+// Objectif :
+//   - Reconstruire les favoris d'un propriétaire en rejouant son journal (dernier état de référence puis ajouts et retraits).
+//   - Remplacer l'état enregistré par le résultat (les changements faits hors journal depuis le dernier ajout
+//     ou retrait sont perdus) et retourner le nombre d'événements rejoués (404 si le journal est vide).
+func RebuildFavorites(owner string) (int, int, error) {
+	replayed := 0
+	err := favoritesStore.Record(owner, func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error) {
+		if len(history) == 0 {
+			return nil, ErrNoFavoritesHistory
+		}
+		*favorites = *replayFavoriteEvents(history)
+		replayed = len(history)
+		return nil, nil
+	})
+	if err != nil {
+		return 0, favoritesErrorStatus(err), err
+	}
+	return replayed, http.StatusOK, nil
+}
+
+// GetFavoritesHistory
+// -------------------
+// Objectif :
+//   - Lister le journal des favoris d'un propriétaire, du plus récent au plus ancien, par page.
+//   - Nommer chaque élément d'après les saisons disponibles (identifiant si la source est indisponible).
+//   - Marquer les événements annulés et celui que le bouton « Undo » annulerait.
+func GetFavoritesHistory(owner, pageParam, perPageParam string) ([]models.FavoriteHistoryEntry, models.Pagination, int, error) {
+
+	// Étape 1 : Lire le journal.
+	history, err := favoritesStore.Events(owner)
+	if err != nil {
+		return nil, models.Pagination{}, http.StatusInternalServerError, err
+	}
+
+	// Étape 2 : Construire les entrées, les plus récentes en tête.
+	entries := favoriteHistoryEntries(history)
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+
+	// Étape 3 : Découper la page demandée.
+	pagination := Paginate(len(entries), pageParam, perPageParam)
+	start, end := pageBounds(pagination)
+	return entries[start:end], pagination, http.StatusOK, nil
+}
+
+// LastFavoriteChange
+// Retourne la modification que « Undo » annulerait (nil s'il n'y en a pas ou si le journal est illisible).
+func LastFavoriteChange(owner string) *models.FavoriteHistoryEntry {
+	if owner == "" {
+		return nil
+	}
+	history, err := favoritesStore.Events(owner)
+	if err != nil {
+		return nil
+	}
+	entries := favoriteHistoryEntries(history)
+	for i := range entries {
+		if entries[i].Undoable {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// recordFavoriteChange
+// --------------------
+// Objectif :
+//   - Appliquer une modification aux favoris et l'ajouter au journal dans la même écriture (rien si change ne modifie rien).
+//   - Compléter l'événement (auteur, date) avant change, qui renseigne l'action et les emplacements.
+//   - Si les favoris ont changé hors journal (collections, ordre, notes, import, fusion), enregistrer d'abord leur état
+//     complet comme référence : le journal rejoué redonne toujours les favoris enregistrés.
+func recordFavoriteChange(owner, actor string, change func(favorites *models.Favorites, history []models.FavoriteEvent, event *models.FavoriteEvent) (bool, error)) error {
+	return favoritesStore.Record(owner, func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error) {
+		before := cloneFavorites(favorites)
+		event := models.FavoriteEvent{Actor: actor, At: favoritesNow()}
+		changed, err := change(favorites, history, &event)
+		if err != nil || !changed {
+			return nil, err
+		}
+
+		recorded := []models.FavoriteEvent{}
+		if !sameFavorites(replayFavoriteEvents(history), before) {
+			recorded = append(recorded, models.FavoriteEvent{Actor: actor, Action: FavoriteEventSnapshot, Snapshot: before, At: event.At})
+		}
+		return append(recorded, event), nil
+	})
+}
+
+// revertFavoriteEvent
+// Applique l'inverse de target aux favoris et décrit dans event les emplacements réellement modifiés
+// (aucun si l'élément a déjà été retiré ou remis entre-temps).
+func revertFavoriteEvent(favorites *models.Favorites, target *models.FavoriteEvent, event *models.FavoriteEvent) {
+	event.Kind, event.ItemID, event.Undoes = target.Kind, target.ItemID, target.ID
+	event.Placements = []models.FavoritePlacement{}
+	if target.Action == FavoriteEventRemove {
+		event.Action = FavoriteEventAdd
+		for _, placement := range target.Placements {
+			if placeFavoriteItem(favorites, placement, event.At) {
+				event.Placements = append(event.Placements, placement)
+			}
+		}
+		return
+	}
+
+	event.Action = FavoriteEventRemove
+	for _, placement := range target.Placements {
+		collection, err := findFavoritesCollection(favorites, placement.Collection)
+		if err != nil {
+			continue
+		}
+		index := placementIndex(collection, placement)
+		if index < 0 {
+			continue
+		}
+		event.Placements = append(event.Placements, models.FavoritePlacement{
+			Collection:     collection.ID,
+			CollectionName: collection.Name,
+			Position:       index + 1,
+			Item:           collection.Items[index],
+		})
+		collection.Items = append(collection.Items[:index:index], collection.Items[index+1:]...)
+	}
+}
+
+// replayFavoriteEvents
+// --------------------
+// Objectif :
+//   - Rejouer un journal à partir de favoris vides : un état de référence remplace les favoris,
+//     un ajout remet chaque élément à sa position, un retrait l'enlève de chaque collection concernée.
+//   - Normaliser après chaque événement (alias des écuries renommées compris), comme le stockage.
+func replayFavoriteEvents(history []models.FavoriteEvent) *models.Favorites {
+	favorites := emptyFavorites()
+	for _, event := range history {
+		switch event.Action {
+		case FavoriteEventSnapshot:
+			if event.Snapshot != nil {
+				favorites = cloneFavorites(event.Snapshot)
+			}
+		case FavoriteEventAdd:
+			for _, placement := range event.Placements {
+				placeFavoriteItem(favorites, placement, event.At)
+			}
+		case FavoriteEventRemove:
+			for _, placement := range event.Placements {
+				collection, err := findFavoritesCollection(favorites, placement.Collection)
+				if err != nil {
+					continue
+				}
+				if index := placementIndex(collection, placement); index >= 0 {
+					collection.Items = append(collection.Items[:index:index], collection.Items[index+1:]...)
+				}
+			}
+		}
+		favorites = normalizeFavorites(favorites)
+	}
+	return favorites
+}
+
+// placeFavoriteItem
+// Remet un élément à sa position dans sa collection (recréée à la date at si elle n'existe plus) ;
+// retourne faux si l'élément y est déjà.
+func placeFavoriteItem(favorites *models.Favorites, placement models.FavoritePlacement, at time.Time) bool {
+	collection, err := findFavoritesCollection(favorites, placement.Collection)
+	if err != nil {
+		favorites.Collections = append(favorites.Collections, models.FavoriteCollection{
+			ID:        placement.Collection,
+			Name:      placement.CollectionName,
+			CreatedAt: at,
+			Items:     []models.FavoriteItem{},
+		})
+		collection = &favorites.Collections[len(favorites.Collections)-1]
+	}
+	if placementIndex(collection, placement) >= 0 {
+		return false
+	}
+
+	target := min(max(placement.Position-1, 0), len(collection.Items))
+	items := make([]models.FavoriteItem, 0, len(collection.Items)+1)
+	items = append(items, collection.Items[:target]...)
+	items = append(items, placement.Item)
+	collection.Items = append(items, collection.Items[target:]...)
+	return true
+}
+
+// placementIndex
+// Retourne l'index de l'élément d'un emplacement dans une collection, identifiant d'écurie renommée compris (-1 s'il n'y est pas).
+func placementIndex(collection *models.FavoriteCollection, placement models.FavoritePlacement) int {
+	kind := placement.Item.Kind
+	return favoriteItemIndex(collection.Items, kind, resolveFavoriteAlias(kind, placement.Item.ID))
+}
+
+// lastUndoableEvent
+// Retourne le dernier ajout ou retrait qui n'est ni une annulation ni déjà annulé (nil s'il n'y en a pas).
+func lastUndoableEvent(history []models.FavoriteEvent) *models.FavoriteEvent {
+	undone := undoneFavoriteEvents(history)
+	for i := len(history) - 1; i >= 0; i-- {
+		event := &history[i]
+		if event.Action != FavoriteEventSnapshot && event.Undoes == 0 && !undone[event.ID] {
+			return event
+		}
+	}
+	return nil
+}
+
+// undoneFavoriteEvents
+// Retourne les identifiants des événements annulés par un événement ultérieur.
+func undoneFavoriteEvents(history []models.FavoriteEvent) map[int64]bool {
+	undone := map[int64]bool{}
+	for _, event := range history {
+		if event.Undoes != 0 {
+			undone[event.Undoes] = true
+		}
+	}
+	return undone
+}
+
+// favoriteHistoryEntries
+// Construit les entrées affichées du journal (dans l'ordre du journal), avec le nom de chaque élément.
+func favoriteHistoryEntries(history []models.FavoriteEvent) []models.FavoriteHistoryEntry {
+	names, _ := knownFavoriteNames()
+	undone := undoneFavoriteEvents(history)
+	undoable := lastUndoableEvent(history)
+
+	entries := make([]models.FavoriteHistoryEntry, 0, len(history))
+	for _, event := range history {
+		name := names[event.Kind][resolveFavoriteAlias(event.Kind, event.ItemID)]
+		if name == "" {
+			name = event.ItemID
+		}
+		entries = append(entries, models.FavoriteHistoryEntry{
+			Event:    event,
+			Name:     name,
+			Undone:   undone[event.ID],
+			Undoable: undoable != nil && undoable.ID == event.ID,
+		})
+	}
+	return entries
+}
+
+// cloneFavorites
+// Retourne une copie indépendante de favoris (collections, éléments et tags compris).
+func cloneFavorites(favorites *models.Favorites) *models.Favorites {
+	data, _ := json.Marshal(favorites)
+	clone := &models.Favorites{}
+	_ = json.Unmarshal(data, clone)
+	return normalizeFavorites(clone)
+}
+
+// sameFavorites
+// Indique si deux favoris sont identiques (collections, ordre, notes, tags et dates).
+func sameFavorites(a, b *models.Favorites) bool {
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
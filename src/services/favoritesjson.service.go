@@ -0,0 +1,538 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"f1-app/helpers"
+	"f1-app/models"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// favoritesBackupCount est le nombre de sauvegardes tournantes conservées (favorites.json.bak.1 = la plus récente).
+const favoritesBackupCount = 3
+
+// JSONFavoritesStore
+// ------------------
+// Stockage des favoris dans un fichier JSON ({"owners": {"user:<id>": {"collections": [...], ...}}, "shares": [...]}).
+//   - Les accès sont sérialisés par un verrou interne et un verrou consultatif sur <fichier>.lock (autres processus).
+//   - Chaque écriture passe par un fichier temporaire synchronisé puis renommé (jamais de fichier tronqué).
+//   - La version précédente est conservée dans <fichier>.bak.1..N ; un fichier illisible est mis de côté
+//     (<fichier>.corrupt-<date>) et remplacé par la sauvegarde valide la plus récente.
+//   - L'ancien format ({"drivers": [...], "constructors": [...]}) est lu comme les favoris de SharedFavoritesOwner ;
+//     des favoris sans collections sont repris dans la collection par défaut.
+//   - Le journal des modifications est un fichier à part (<nom>.events.jsonl), en ajout seul.
+type JSONFavoritesStore struct {
+	// Path est le chemin du fichier (vide : GetFavoritesFilePath, évalué à chaque accès).
+	Path string
+	mu   sync.Mutex
+}
+
+// NewJSONFavoritesStore
+// Crée un stockage JSON sur le fichier donné (vide : emplacement par défaut).
+func NewJSONFavoritesStore(path string) *JSONFavoritesStore {
+	return &JSONFavoritesStore{Path: path}
+}
+
+// filePath
+// Retourne le chemin du fichier des favoris.
+func (s *JSONFavoritesStore) filePath() string {
+	if s.Path == "" {
+		return GetFavoritesFilePath()
+	}
+	return s.Path
+}
+
+// Load
+// Charge les favoris d'un propriétaire (vides s'il n'en a pas). Crée le fichier s'il n'existe pas encore.
+func (s *JSONFavoritesStore) Load(owner string) (*models.Favorites, error) {
+	if owner == "" {
+		return emptyFavorites(), nil
+	}
+	favorites := emptyFavorites()
+	err := s.withLock(func(path string) error {
+		file, err := s.load(path)
+		if err != nil {
+			return err
+		}
+		if stored := file.Owners[owner]; stored != nil {
+			favorites = stored
+		}
+		return nil
+	})
+	return favorites, err
+}
+
+// Save
+// Remplace les favoris d'un propriétaire.
+func (s *JSONFavoritesStore) Save(owner string, favorites *models.Favorites) error {
+	return s.Update(owner, func(current *models.Favorites) error {
+		*current = *favorites
+		return nil
+	})
+}
+
+// Update
+// Applique fn aux favoris d'un propriétaire puis enregistre le fichier, sous verrou (rien n'est écrit si fn échoue).
+func (s *JSONFavoritesStore) Update(owner string, fn func(favorites *models.Favorites) error) error {
+	return s.update(owner, false, func(favorites *models.Favorites, _ []models.FavoriteEvent) ([]models.FavoriteEvent, error) {
+		return nil, fn(favorites)
+	})
+}
+
+// Record
+// Comme Update, en donnant aussi à fn le journal du propriétaire ; les événements retournés sont ajoutés
+// au journal (<fichier>.events.jsonl) après l'enregistrement des favoris, sous le même verrou.
+func (s *JSONFavoritesStore) Record(owner string, fn func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error)) error {
+	return s.update(owner, true, fn)
+}
+
+// Events
+// Retourne le journal des favoris d'un propriétaire, du plus ancien au plus récent.
+func (s *JSONFavoritesStore) Events(owner string) ([]models.FavoriteEvent, error) {
+	history := []models.FavoriteEvent{}
+	err := s.withLock(func(path string) error {
+		events, _, err := readFavoriteEvents(favoritesEventsPath(path))
+		history = ownerFavoriteEvents(events, owner)
+		return err
+	})
+	return history, err
+}
+
+// update
+// ------
+// Objectif :
+//   - Appliquer fn aux favoris d'un propriétaire sous verrou, avec son journal si withHistory est vrai.
+//   - Enregistrer le fichier des favoris, puis numéroter et ajouter au journal les événements retournés (rien n'est
+//     écrit si fn échoue, et le journal ne l'est jamais pour une modification non enregistrée).
+func (s *JSONFavoritesStore) update(owner string, withHistory bool, fn func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error)) error {
+	if owner == "" {
+		return ErrNoFavoritesOwner
+	}
+	return s.withLock(func(path string) error {
+
+		// Étape 1 : Lire les favoris et, si demandé, le journal du propriétaire.
+		file, err := s.load(path)
+		if err != nil {
+			return err
+		}
+		favorites := file.Owners[owner]
+		if favorites == nil {
+			favorites = emptyFavorites()
+		}
+		var events []models.FavoriteEvent
+		var lastID int64
+		if withHistory {
+			if events, lastID, err = readFavoriteEvents(favoritesEventsPath(path)); err != nil {
+				return err
+			}
+		}
+
+		// Étape 2 : Appliquer la modification.
+		recorded, err := fn(favorites, ownerFavoriteEvents(events, owner))
+		if err != nil {
+			return err
+		}
+
+		// Étape 3 : Enregistrer les favoris.
+		file.Owners[owner] = normalizeFavorites(favorites)
+		if err := s.save(path, file); err != nil {
+			return err
+		}
+
+		// Étape 4 : Compléter le journal une fois la modification enregistrée. En cas d'échec, la modification reste
+		// acquise : au changement journalisé suivant, l'état complet des favoris est enregistré comme référence
+		// (voir recordFavoriteChange), si bien que le journal rejoué redonne les favoris enregistrés.
+		for i := range recorded {
+			lastID++
+			recorded[i].ID = lastID
+			recorded[i].Owner = owner
+		}
+		if err := appendFavoriteEvents(favoritesEventsPath(path), recorded); err != nil {
+			log.Printf("favoris : journal de %s non complété après l'enregistrement (%v)", owner, err)
+		}
+		return nil
+	})
+}
+
+// Add
+// Ajoute un élément à la collection par défaut d'un propriétaire s'il n'y est pas déjà.
+func (s *JSONFavoritesStore) Add(owner, kind, id string) error {
+	return s.Update(owner, func(favorites *models.Favorites) error {
+		return addFavoriteItem(favorites, DefaultFavoritesCollectionID, kind, id)
+	})
+}
+
+// Remove
+// Supprime un élément de toutes les collections d'un propriétaire.
+func (s *JSONFavoritesStore) Remove(owner, kind, id string) error {
+	return s.Update(owner, func(favorites *models.Favorites) error {
+		return removeFavoriteItem(favorites, "", kind, id)
+	})
+}
+
+// Delete
+// Supprime tous les favoris d'un propriétaire et son lien de partage.
+func (s *JSONFavoritesStore) Delete(owner string) error {
+	if owner == "" {
+		return nil
+	}
+	return s.withLock(func(path string) error {
+		file, err := s.load(path)
+		if err != nil {
+			return err
+		}
+		_, exists := file.Owners[owner]
+		shares := len(file.Shares)
+		file.Shares = removeOwnerShares(file.Shares, owner)
+		if !exists && len(file.Shares) == shares {
+			return nil
+		}
+		delete(file.Owners, owner)
+		return s.save(path, file)
+	})
+}
+
+// SaveShare
+// Enregistre le lien de partage d'un propriétaire (remplace son lien précédent).
+func (s *JSONFavoritesStore) SaveShare(share *models.FavoritesShare) error {
+	if share.Owner == "" {
+		return ErrNoFavoritesOwner
+	}
+	return s.withLock(func(path string) error {
+		file, err := s.load(path)
+		if err != nil {
+			return err
+		}
+		file.Shares = append(removeOwnerShares(file.Shares, share.Owner), *share)
+		return s.save(path, file)
+	})
+}
+
+// FindShare
+// Retourne le lien de partage correspondant à un jeton (ErrShareNotFound s'il n'existe pas).
+func (s *JSONFavoritesStore) FindShare(token string) (*models.FavoritesShare, error) {
+	return s.findShare(func(share models.FavoritesShare) bool { return share.Token == token })
+}
+
+// OwnerShare
+// Retourne le lien de partage d'un propriétaire (ErrShareNotFound s'il n'en a pas).
+func (s *JSONFavoritesStore) OwnerShare(owner string) (*models.FavoritesShare, error) {
+	return s.findShare(func(share models.FavoritesShare) bool { return share.Owner == owner })
+}
+
+// DeleteShare
+// Révoque le lien de partage d'un propriétaire.
+func (s *JSONFavoritesStore) DeleteShare(owner string) error {
+	return s.withLock(func(path string) error {
+		file, err := s.load(path)
+		if err != nil {
+			return err
+		}
+		shares := len(file.Shares)
+		file.Shares = removeOwnerShares(file.Shares, owner)
+		if len(file.Shares) == shares {
+			return nil
+		}
+		return s.save(path, file)
+	})
+}
+
+// findShare
+// Retourne le premier lien de partage satisfaisant match (ErrShareNotFound sinon).
+func (s *JSONFavoritesStore) findShare(match func(share models.FavoritesShare) bool) (*models.FavoritesShare, error) {
+	var found *models.FavoritesShare
+	err := s.withLock(func(path string) error {
+		file, err := s.load(path)
+		if err != nil {
+			return err
+		}
+		for _, share := range file.Shares {
+			if match(share) {
+				found = &share
+				return nil
+			}
+		}
+		return ErrShareNotFound
+	})
+	return found, err
+}
+
+// removeOwnerShares
+// Retourne les liens de partage sans ceux du propriétaire donné.
+func removeOwnerShares(shares []models.FavoritesShare, owner string) []models.FavoritesShare {
+	kept := []models.FavoritesShare{}
+	for _, share := range shares {
+		if share.Owner != owner {
+			kept = append(kept, share)
+		}
+	}
+	return kept
+}
+
+// withLock
+// Exécute fn sous le verrou interne et le verrou de fichier (<fichier>.lock).
+func (s *JSONFavoritesStore) withLock(fn func(path string) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.filePath()
+	unlock, err := helpers.LockFile(path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return fn(path)
+}
+
+// load
+// ----
+// Objectif :
+//   - Lire le fichier (appelant verrouillé) et le créer vide s'il n'existe pas encore.
+//   - Restaurer automatiquement la dernière sauvegarde valide si le fichier est illisible.
+func (s *JSONFavoritesStore) load(path string) (*models.FavoritesFile, error) {
+
+	// Étape 1 : Créer le fichier s'il n'existe pas encore.
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		file := &models.FavoritesFile{Owners: map[string]*models.Favorites{}}
+		if err := s.save(path, file); err != nil {
+			return nil, err
+		}
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture fichier favoris: %w", err)
+	}
+
+	// Étape 2 : Décoder le fichier, ou le réparer s'il est corrompu.
+	file, err := decodeFavorites(data)
+	if err != nil {
+		return s.recover(path, err)
+	}
+	return file, nil
+}
+
+// recover
+// -------
+// Objectif :
+//   - Mettre de côté le fichier corrompu (<fichier>.corrupt-<date>) pour analyse.
+//   - Restaurer la sauvegarde valide la plus récente, ou repartir de favoris vides s'il n'y en a aucune.
+func (s *JSONFavoritesStore) recover(path string, cause error) (*models.FavoritesFile, error) {
+
+	// Étape 1 : Mettre le fichier corrompu de côté.
+	corruptPath := fmt.Sprintf("%s.corrupt-%s", path, time.Now().UTC().Format("20060102T150405.000Z"))
+	if err := os.Rename(path, corruptPath); err != nil {
+		return nil, fmt.Errorf("erreur mise de côté fichier favoris corrompu: %w", err)
+	}
+	log.Printf("favoris : %s illisible (%v), copie conservée dans %s", path, cause, corruptPath)
+
+	// Étape 2 : Restaurer la sauvegarde valide la plus récente.
+	for i := 1; i <= favoritesBackupCount; i++ {
+		backupPath := favoritesBackupPath(path, i)
+		data, err := os.ReadFile(backupPath)
+		if err != nil {
+			continue
+		}
+		file, err := decodeFavorites(data)
+		if err != nil {
+			continue
+		}
+		if err := helpers.WriteFileAtomic(path, data, 0644); err != nil {
+			return nil, err
+		}
+		log.Printf("favoris : restaurés depuis %s", backupPath)
+		return file, nil
+	}
+
+	// Étape 3 : Aucune sauvegarde exploitable, repartir de favoris vides.
+	log.Printf("favoris : aucune sauvegarde valide, réinitialisation de %s", path)
+	file := &models.FavoritesFile{Owners: map[string]*models.Favorites{}}
+	if err := s.save(path, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// save
+// ----
+// Objectif :
+//   - Conserver la version actuelle du fichier dans les sauvegardes tournantes (si elle change et est valide).
+//   - Écrire le nouveau contenu de façon atomique (appelant verrouillé).
+func (s *JSONFavoritesStore) save(path string, file *models.FavoritesFile) error {
+	data, err := json.MarshalIndent(file, "", "    ")
+	if err != nil {
+		return fmt.Errorf("erreur encodage JSON favoris: %w", err)
+	}
+
+	if err := rotateFavoritesBackups(path, data); err != nil {
+		return err
+	}
+	if err := helpers.WriteFileAtomic(path, data, 0644); err != nil {
+		return fmt.Errorf("erreur écriture fichier favoris: %w", err)
+	}
+	return nil
+}
+
+// rotateFavoritesBackups
+// ----------------------
+// Objectif :
+//   - Décaler les sauvegardes (.bak.1 -> .bak.2 ...), la plus ancienne étant écrasée.
+//   - Copier le fichier actuel dans .bak.1, sauf s'il est absent, corrompu ou identique au nouveau contenu.
+func rotateFavoritesBackups(path string, next []byte) error {
+	current, err := os.ReadFile(path)
+	if err != nil || bytes.Equal(current, next) {
+		return nil
+	}
+	if _, err := decodeFavorites(current); err != nil {
+		return nil
+	}
+
+	for i := favoritesBackupCount - 1; i >= 1; i-- {
+		_ = os.Rename(favoritesBackupPath(path, i), favoritesBackupPath(path, i+1))
+	}
+	if err := helpers.WriteFileAtomic(favoritesBackupPath(path, 1), current, 0644); err != nil {
+		return fmt.Errorf("erreur sauvegarde fichier favoris: %w", err)
+	}
+	return nil
+}
+
+// favoritesBackupPath
+// Retourne le chemin de la sauvegarde n°i du fichier des favoris.
+func favoritesBackupPath(path string, i int) string {
+	return fmt.Sprintf("%s.bak.%d", path, i)
+}
+
+// favoritesEventsPath
+// Retourne le chemin du journal des favoris (favorites.json -> favorites.events.jsonl).
+func favoritesEventsPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".events.jsonl"
+}
+
+// readFavoriteEvents
+// ------------------
+// Objectif :
+//   - Lire le journal des favoris (un événement JSON par ligne), vide s'il n'existe pas encore.
+//   - Ignorer une ligne illisible (écriture interrompue par un crash) en le signalant dans les logs.
+//   - Retourner aussi le plus grand identifiant d'événement, pour numéroter les suivants.
+func readFavoriteEvents(path string) ([]models.FavoriteEvent, int64, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []models.FavoriteEvent{}, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("erreur lecture journal des favoris: %w", err)
+	}
+
+	events := []models.FavoriteEvent{}
+	var lastID int64
+	for number, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var event models.FavoriteEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			log.Printf("favoris : ligne %d du journal %s ignorée (%v)", number+1, path, err)
+			continue
+		}
+		events = append(events, event)
+		lastID = max(lastID, event.ID)
+	}
+	return events, lastID, nil
+}
+
+// appendFavoriteEvents
+// Ajoute des événements en fin de journal et synchronise le fichier sur disque (le journal n'est jamais réécrit).
+func appendFavoriteEvents(path string, events []models.FavoriteEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("erreur encodage journal des favoris: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("erreur ouverture journal des favoris: %w", err)
+	}
+	defer file.Close()
+
+	// Terminer une dernière ligne interrompue pour ne pas la coller au nouvel événement.
+	data := buf.Bytes()
+	if info, err := file.Stat(); err == nil && info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err := file.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+			data = append([]byte("\n"), data...)
+		}
+	}
+	if _, err := file.Write(data); err != nil {
+		return fmt.Errorf("erreur écriture journal des favoris: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("erreur écriture journal des favoris: %w", err)
+	}
+	return nil
+}
+
+// ownerFavoriteEvents
+// Retourne les événements d'un propriétaire, dans l'ordre du journal.
+func ownerFavoriteEvents(events []models.FavoriteEvent, owner string) []models.FavoriteEvent {
+	history := []models.FavoriteEvent{}
+	for _, event := range events {
+		if event.Owner == owner {
+			history = append(history, event)
+		}
+	}
+	return history
+}
+
+// readFavoritesFile
+// Lit et décode un fichier de favoris JSON.
+func readFavoritesFile(filePath string) (*models.FavoritesFile, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture fichier favoris: %w", err)
+	}
+	return decodeFavorites(data)
+}
+
+// decodeFavorites
+// ---------------
+// Objectif :
+//   - Décoder le contenu d'un fichier de favoris (normalisé : collection par défaut, listes non nil).
+//   - Lire l'ancien format global ({"drivers", "constructors"}) comme les favoris de SharedFavoritesOwner.
+func decodeFavorites(data []byte) (*models.FavoritesFile, error) {
+	var content struct {
+		models.FavoritesFile
+		Drivers      []string `json:"drivers"`
+		Constructors []string `json:"constructors"`
+	}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("erreur décodage JSON favoris: %w", err)
+	}
+
+	file := &models.FavoritesFile{Owners: map[string]*models.Favorites{}, Shares: content.Shares}
+	for owner, favorites := range content.Owners {
+		if favorites != nil {
+			file.Owners[owner] = normalizeFavorites(favorites)
+		}
+	}
+	if content.Owners == nil && (len(content.Drivers) > 0 || len(content.Constructors) > 0) {
+		file.Owners[SharedFavoritesOwner] = normalizeFavorites(&models.Favorites{
+			Drivers:      content.Drivers,
+			Constructors: content.Constructors,
+		})
+	}
+	return file, nil
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrInvalidConstructorAliases signale une table d'alias mal formée (F1_CONSTRUCTOR_ALIASES).
+var ErrInvalidConstructorAliases = errors.New("alias d'écuries invalides (format : ancien=nouveau,ancien2=nouveau2)")
+
+// constructorIDAliases
+// Table de migration des identifiants d'écuries renommées (ancien -> nouveau), appliquée au chargement des favoris
+// seulement si l'ancien identifiant n'existe dans aucune saison disponible (voir resolveFavoriteAlias).
+// Les chaînes sont suivies (toro_rosso -> alphatauri -> rb). Un renommage à venir (ex. "sauber": "audi") reste sans effet
+// tant qu'une saison disponible connaît l'ancien identifiant.
+var constructorIDAliases = map[string]string{
+	"toro_rosso":   "alphatauri",
+	"alphatauri":   "rb",
+	"force_india":  "racing_point",
+	"racing_point": "aston_martin",
+	"lotus_f1":     "renault",
+	"renault":      "alpine",
+	"alfa":         "sauber",
+}
+
+// AddConstructorAliases
+// Complète (ou remplace entrée par entrée) la table des identifiants d'écuries renommées (appelé au démarrage).
+func AddConstructorAliases(aliases map[string]string) {
+	for oldID, newID := range aliases {
+		constructorIDAliases[oldID] = newID
+	}
+}
+
+// ParseConstructorAliases
+// Lit une table d'alias "ancien=nouveau,ancien2=nouveau2" (variable F1_CONSTRUCTOR_ALIASES).
+func ParseConstructorAliases(input string) (map[string]string, error) {
+	aliases := map[string]string{}
+	for _, pair := range strings.Split(input, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		oldID, newID, ok := strings.Cut(pair, "=")
+		oldID, newID = strings.TrimSpace(oldID), strings.TrimSpace(newID)
+		if !ok || oldID == "" || newID == "" || oldID == newID {
+			return nil, fmt.Errorf("%w : %q", ErrInvalidConstructorAliases, pair)
+		}
+		aliases[oldID] = newID
+	}
+	return aliases, nil
+}
+
+// resolveFavoriteAlias
+// Retourne l'identifiant actuel d'un élément : suit les alias d'écuries tant que l'identifiant n'existe dans aucune
+// saison disponible (renault reste renault si une saison le connaît), sans boucler sur une table cyclique.
+func resolveFavoriteAlias(kind, id string) string {
+	if kind != FavoriteConstructor {
+		return id
+	}
+	seen := map[string]bool{}
+	for !seen[id] {
+		seen[id] = true
+		next, ok := constructorIDAliases[id]
+		if !ok {
+			break
+		}
+		if exists, err := constructorExistsInSeasons(id); err != nil || exists {
+			break // écurie présente dans une saison (ou saisons invérifiables) : l'identifiant est conservé
+		}
+		id = next
+	}
+	return id
+}
+
+// constructorExistsInSeasons
+// Indique si une écurie existe dans au moins une saison disponible (index des saisons).
+func constructorExistsInSeasons(constructorID string) (bool, error) {
+	for _, season := range GetSeasons() {
+		index, err := seasonIndexFor(season)
+		if err != nil {
+			return false, err
+		}
+		if _, ok := index.constructorsByID[constructorID]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// migrateFavoriteItem
+// Remplace l'identifiant d'un élément renommé par l'identifiant actuel, en conservant le premier ancien identifiant.
+func migrateFavoriteItem(item *models.FavoriteItem) {
+	current := resolveFavoriteAlias(item.Kind, item.ID)
+	if current == item.ID {
+		return
+	}
+	if item.PreviousID == "" {
+		item.PreviousID = item.ID
+	}
+	item.ID = current
+}
+
+// FindOrphanedFavorites
+// ---------------------
+// Objectif :
+//   - Retrouver les éléments des favoris dont l'identifiant n'existe dans aucune saison disponible
+//     (pilote ayant quitté la grille, écurie disparue ou renommée sans alias).
+//   - Retourner chaque élément une seule fois, même s'il figure dans plusieurs collections.
+func FindOrphanedFavorites(favorites *models.Favorites) ([]models.FavoriteItem, error) {
+	orphans := []models.FavoriteItem{}
+	known, err := knownFavoriteNames()
+	if err != nil {
+		return orphans, err
+	}
+	for _, collection := range favorites.Collections {
+		for _, item := range collection.Items {
+			if known[item.Kind][item.ID] == "" && favoriteItemIndex(orphans, item.Kind, item.ID) < 0 {
+				orphans = append(orphans, item)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// RemoveOrphanedFavorites
+// -----------------------
+// Objectif :
+//   - Retirer de toutes les collections d'un propriétaire les éléments qui n'existent plus dans aucune saison.
+//   - Retourner le nombre d'éléments retirés (502 si les saisons ne peuvent pas être vérifiées).
+func RemoveOrphanedFavorites(owner string) (int, int, error) {
+	known, err := knownFavoriteNames()
+	if err != nil {
+		return 0, http.StatusBadGateway, err
+	}
+	removed := 0
+	err = favoritesStore.Update(owner, func(favorites *models.Favorites) error {
+		removed = 0
+		for i := range favorites.Collections {
+			collection := &favorites.Collections[i]
+			items := []models.FavoriteItem{}
+			for _, item := range collection.Items {
+				if known[item.Kind][item.ID] != "" {
+					items = append(items, item)
+				} else {
+					removed++
+				}
+			}
+			collection.Items = items
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, favoritesErrorStatus(err), err
+	}
+	return removed, http.StatusOK, nil
+}
+
+// OrphanedEntries
+// Retourne les éléments orphelins d'une page de favoris, chacun une seule fois.
+func OrphanedEntries(groups []models.FavoriteGroup) []models.FavoriteItem {
+	orphans := []models.FavoriteItem{}
+	for _, group := range groups {
+		for _, entry := range group.Entries {
+			if entry.Orphaned && favoriteItemIndex(orphans, entry.Item.Kind, entry.Item.ID) < 0 {
+				orphans = append(orphans, entry.Item)
+			}
+		}
+	}
+	return orphans
+}
+
+// knownFavoriteNames
+// Retourne le nom des pilotes et écuries de toutes les saisons disponibles, par type de favori puis identifiant
+// (nom de la saison la plus récente ; un identifiant absent n'existe dans aucune saison).
+func knownFavoriteNames() (map[string]map[string]string, error) {
+	known := map[string]map[string]string{FavoriteDriver: {}, FavoriteConstructor: {}}
+	for _, season := range GetSeasons() {
+		index, err := seasonIndexFor(season)
+		if err != nil {
+			return nil, fmt.Errorf("impossible de vérifier les pilotes et écuries de la saison %s: %w", season, err)
+		}
+		for _, driver := range index.drivers {
+			if known[FavoriteDriver][driver.DriverID] == "" {
+				known[FavoriteDriver][driver.DriverID] = driver.GivenName + " " + driver.FamilyName
+			}
+		}
+		for id, constructor := range index.constructorsByID {
+			if known[FavoriteConstructor][id] == "" {
+				known[FavoriteConstructor][id] = constructor.Name
+			}
+		}
+	}
+	return known, nil
+}
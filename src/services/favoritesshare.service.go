@@ -0,0 +1,74 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"f1-app/models"
+	"net/http"
+	"regexp"
+)
+
+// shareTokenPattern décrit les jetons générés par ShareFavorites (32 octets aléatoires en base64url).
+var shareTokenPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+// ShareFavorites
+// --------------
+// Objectif :
+//   - Créer un lien de partage en lecture seule des favoris d'un propriétaire (jeton aléatoire non devinable).
+//   - Remplacer le lien précédent s'il existe : l'ancien jeton cesse aussitôt de fonctionner.
+//   - Retourner 400 si le visiteur n'est pas identifié.
+func ShareFavorites(owner string) (*models.FavoritesShare, int, error) {
+	if owner == "" {
+		return nil, http.StatusBadRequest, ErrNoFavoritesOwner
+	}
+	share := &models.FavoritesShare{
+		Token:     base64.RawURLEncoding.EncodeToString(randomBytes(32)),
+		Owner:     owner,
+		CreatedAt: favoritesNow(),
+	}
+	if err := favoritesStore.SaveShare(share); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return share, http.StatusCreated, nil
+}
+
+// StopSharingFavorites
+// Révoque le lien de partage d'un propriétaire (sans effet s'il n'en a pas).
+func StopSharingFavorites(owner string) (int, error) {
+	if owner == "" {
+		return http.StatusBadRequest, ErrNoFavoritesOwner
+	}
+	if err := favoritesStore.DeleteShare(owner); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// FavoritesShareOf
+// Retourne le lien de partage actif d'un propriétaire (nil s'il n'en a pas).
+func FavoritesShareOf(owner string) (*models.FavoritesShare, error) {
+	if owner == "" {
+		return nil, nil
+	}
+	share, err := favoritesStore.OwnerShare(owner)
+	if errors.Is(err, ErrShareNotFound) {
+		return nil, nil
+	}
+	return share, err
+}
+
+// FindSharedFavorites
+// Retourne le propriétaire des favoris partagés par un jeton (404 si le jeton est mal formé, inconnu ou révoqué).
+func FindSharedFavorites(token string) (string, int, error) {
+	if !shareTokenPattern.MatchString(token) {
+		return "", http.StatusNotFound, ErrShareNotFound
+	}
+	share, err := favoritesStore.FindShare(token)
+	if errors.Is(err, ErrShareNotFound) {
+		return "", http.StatusNotFound, err
+	}
+	if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	return share.Owner, http.StatusOK, nil
+}
@@ -0,0 +1,654 @@
+package services
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"os"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// favoritesDBFileName est le nom par défaut de la base SQLite des favoris.
+const favoritesDBFileName = "favorites.db"
+
+// favoritesJSONImportKey marque dans store_meta l'import (unique) du fichier favorites.json.
+const favoritesJSONImportKey = "favorites_json_import"
+
+// favoritesMigrations
+// Migrations du schéma SQLite, appliquées dans l'ordre (version = index + 1) et enregistrées dans schema_migrations.
+var favoritesMigrations = []string{
+	// Version 1 : favoris ordonnés par type et métadonnées du stockage.
+	`CREATE TABLE favorites (
+		kind       TEXT    NOT NULL CHECK (kind IN ('driver', 'constructor')),
+		item_id    TEXT    NOT NULL,
+		position   INTEGER NOT NULL,
+		created_at TEXT    NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%SZ', 'now')),
+		PRIMARY KEY (kind, item_id)
+	);
+	CREATE TABLE store_meta (
+		key   TEXT PRIMARY KEY,
+		value TEXT NOT NULL
+	);`,
+	// Version 2 : favoris par propriétaire (les favoris globaux existants deviennent ceux de "shared",
+	// cf. SharedFavoritesOwner), comptes utilisateurs et sessions.
+	`CREATE TABLE favorites_v2 (
+		owner      TEXT    NOT NULL,
+		kind       TEXT    NOT NULL CHECK (kind IN ('driver', 'constructor')),
+		item_id    TEXT    NOT NULL,
+		position   INTEGER NOT NULL,
+		created_at TEXT    NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%SZ', 'now')),
+		PRIMARY KEY (owner, kind, item_id)
+	);
+	INSERT INTO favorites_v2 (owner, kind, item_id, position, created_at)
+		SELECT 'shared', kind, item_id, position, created_at FROM favorites;
+	DROP TABLE favorites;
+	ALTER TABLE favorites_v2 RENAME TO favorites;
+	CREATE TABLE users (
+		id            TEXT PRIMARY KEY,
+		username      TEXT NOT NULL UNIQUE,
+		password_hash TEXT NOT NULL,
+		created_at    TEXT NOT NULL
+	);
+	CREATE TABLE sessions (
+		token_hash TEXT PRIMARY KEY,
+		user_id    TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		created_at TEXT NOT NULL,
+		expires_at TEXT NOT NULL
+	);
+	CREATE INDEX sessions_user_id ON sessions (user_id);`,
+	// Version 3 : collections nommées et ordonnées ; chaque élément porte sa position dans la collection,
+	// une note, des tags (tableau JSON) et sa date d'ajout. Les favoris existants rejoignent la collection
+	// par défaut (cf. DefaultFavoritesCollectionID), pilotes puis écuries.
+	`CREATE TABLE favorite_collections (
+		owner      TEXT    NOT NULL,
+		id         TEXT    NOT NULL,
+		name       TEXT    NOT NULL,
+		position   INTEGER NOT NULL,
+		created_at TEXT    NOT NULL DEFAULT '',
+		PRIMARY KEY (owner, id)
+	);
+	CREATE TABLE favorite_items (
+		owner         TEXT    NOT NULL,
+		collection_id TEXT    NOT NULL,
+		kind          TEXT    NOT NULL CHECK (kind IN ('driver', 'constructor')),
+		item_id       TEXT    NOT NULL,
+		position      INTEGER NOT NULL,
+		note          TEXT    NOT NULL DEFAULT '',
+		tags          TEXT    NOT NULL DEFAULT '[]',
+		added_at      TEXT    NOT NULL DEFAULT '',
+		PRIMARY KEY (owner, collection_id, kind, item_id),
+		FOREIGN KEY (owner, collection_id) REFERENCES favorite_collections (owner, id) ON DELETE CASCADE
+	);
+	INSERT INTO favorite_collections (owner, id, name, position, created_at)
+		SELECT owner, 'default', 'Favorites', 0, MIN(created_at) FROM favorites GROUP BY owner;
+	INSERT INTO favorite_items (owner, collection_id, kind, item_id, position, added_at)
+		SELECT owner, 'default', kind, item_id,
+			ROW_NUMBER() OVER (PARTITION BY owner ORDER BY kind = 'constructor', position), created_at
+		FROM favorites;
+	DROP TABLE favorites;`,
+	// Version 4 : liens de partage en lecture seule, un par propriétaire.
+	`CREATE TABLE favorite_shares (
+		token      TEXT PRIMARY KEY,
+		owner      TEXT NOT NULL UNIQUE,
+		created_at TEXT NOT NULL
+	);`,
+	// Version 5 : ancien identifiant des écuries renommées (cf. constructorIDAliases).
+	`ALTER TABLE favorite_items ADD COLUMN previous_id TEXT NOT NULL DEFAULT '';`,
+	// Version 6 : journal des modifications des favoris, en ajout seul (les triggers refusent modification
+	// et suppression). Emplacements et état de référence sont enregistrés en JSON.
+	`CREATE TABLE favorite_events (
+		id         INTEGER PRIMARY KEY AUTOINCREMENT,
+		owner      TEXT    NOT NULL,
+		actor      TEXT    NOT NULL DEFAULT '',
+		action     TEXT    NOT NULL CHECK (action IN ('add', 'remove', 'snapshot')),
+		kind       TEXT    NOT NULL DEFAULT '',
+		item_id    TEXT    NOT NULL DEFAULT '',
+		placements TEXT    NOT NULL DEFAULT '[]',
+		undoes     INTEGER NOT NULL DEFAULT 0,
+		snapshot   TEXT    NOT NULL DEFAULT '',
+		created_at TEXT    NOT NULL
+	);
+	CREATE INDEX favorite_events_owner ON favorite_events (owner, id);
+	CREATE TRIGGER favorite_events_no_update BEFORE UPDATE ON favorite_events
+	BEGIN
+		SELECT RAISE(ABORT, 'journal des favoris en ajout seul');
+	END;
+	CREATE TRIGGER favorite_events_no_delete BEFORE DELETE ON favorite_events
+	BEGIN
+		SELECT RAISE(ABORT, 'journal des favoris en ajout seul');
+	END;`,
+}
+
+// SQLiteFavoritesStore
+// Stockage des favoris dans une base SQLite embarquée (driver pur Go modernc.org/sqlite).
+// La même base contient les comptes et les sessions (voir Users).
+type SQLiteFavoritesStore struct {
+	db *sql.DB
+}
+
+// OpenSQLiteFavoritesStore
+// ------------------------
+// Objectif :
+//   - Ouvrir (ou créer) la base SQLite au chemin donné.
+//   - Limiter le pool à une connexion (SQLite n'accepte qu'un écrivain) et attendre un verrou au lieu d'échouer.
+//   - Appliquer les migrations manquantes.
+func OpenSQLiteFavoritesStore(path string) (*SQLiteFavoritesStore, error) {
+
+	// Étape 1 : Ouvrir la base avec un délai d'attente sur les verrous (transactions en écriture dès leur début).
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=synchronous(FULL)&_pragma=foreign_keys(1)&_txlock=immediate")
+	if err != nil {
+		return nil, fmt.Errorf("erreur ouverture base favoris: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	// Étape 2 : Mettre le schéma à jour.
+	if err := migrateFavoritesDB(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &SQLiteFavoritesStore{db: db}, nil
+}
+
+// migrateFavoritesDB
+// ------------------
+// Objectif :
+//   - Créer la table schema_migrations si besoin.
+//   - Appliquer chaque migration manquante dans sa propre transaction et enregistrer sa version.
+//   - Relire la version dans la transaction : deux processus démarrant ensemble n'appliquent pas deux fois la même migration.
+func migrateFavoritesDB(db *sql.DB) error {
+
+	// Étape 1 : Créer la table des versions appliquées.
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TEXT    NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("erreur création table des migrations: %w", err)
+	}
+
+	// Étape 2 : Appliquer les migrations manquantes une par une.
+	for {
+		applied, err := applyNextFavoritesMigration(db)
+		if err != nil || !applied {
+			return err
+		}
+	}
+}
+
+// applyNextFavoritesMigration
+// Applique la migration suivant la version courante ; retourne faux si le schéma est à jour.
+func applyNextFavoritesMigration(db *sql.DB) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback() // sans effet après Commit
+
+	var current int
+	if err := tx.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return false, fmt.Errorf("erreur lecture version du schéma: %w", err)
+	}
+	if current > len(favoritesMigrations) {
+		return false, fmt.Errorf("base des favoris en version %d, plus récente que l'application (%d)", current, len(favoritesMigrations))
+	}
+	if current == len(favoritesMigrations) {
+		return false, nil
+	}
+
+	version := current + 1
+	if _, err := tx.Exec(favoritesMigrations[version-1]); err != nil {
+		return false, fmt.Errorf("erreur migration %d de la base favoris: %w", version, err)
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`, version, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// favoritesQuerier est implémentée par *sql.DB et *sql.Tx (lecture hors ou dans une transaction).
+type favoritesQuerier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Load
+// Charge les collections d'un propriétaire et leurs éléments, dans l'ordre choisi par l'utilisateur.
+func (s *SQLiteFavoritesStore) Load(owner string) (*models.Favorites, error) {
+	return loadSQLiteFavorites(s.db, owner)
+}
+
+// Save
+// Remplace tous les favoris d'un propriétaire par ceux fournis.
+func (s *SQLiteFavoritesStore) Save(owner string, favorites *models.Favorites) error {
+	return s.Update(owner, func(current *models.Favorites) error {
+		*current = *favorites
+		return nil
+	})
+}
+
+// Update
+// Applique fn aux favoris d'un propriétaire et enregistre le résultat dans une transaction (annulée si fn échoue).
+func (s *SQLiteFavoritesStore) Update(owner string, fn func(favorites *models.Favorites) error) error {
+	return s.update(owner, false, func(favorites *models.Favorites, _ []models.FavoriteEvent) ([]models.FavoriteEvent, error) {
+		return nil, fn(favorites)
+	})
+}
+
+// Record
+// Comme Update, en donnant aussi à fn le journal du propriétaire ; les événements retournés sont ajoutés
+// à la table favorite_events dans la même transaction que les favoris.
+func (s *SQLiteFavoritesStore) Record(owner string, fn func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error)) error {
+	return s.update(owner, true, fn)
+}
+
+// Events
+// Retourne le journal des favoris d'un propriétaire, du plus ancien au plus récent.
+func (s *SQLiteFavoritesStore) Events(owner string) ([]models.FavoriteEvent, error) {
+	return loadSQLiteEvents(s.db, owner)
+}
+
+// update
+// ------
+// Objectif :
+//   - Appliquer fn aux favoris d'un propriétaire dans une transaction qui réserve l'écriture dès son début (_txlock=immediate),
+//     avec son journal si withHistory est vrai.
+//   - Réécrire les collections et éléments du propriétaire (dates d'ajout, notes et tags sont portés par les éléments)
+//     et ajouter au journal les événements retournés.
+//   - Annuler la transaction si fn ou une écriture échoue.
+func (s *SQLiteFavoritesStore) update(owner string, withHistory bool, fn func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error)) error {
+	if owner == "" {
+		return ErrNoFavoritesOwner
+	}
+
+	// Étape 1 : Ouvrir la transaction et lire les favoris courants (et le journal si demandé).
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("erreur transaction favoris: %w", err)
+	}
+	defer tx.Rollback() // sans effet après Commit
+
+	favorites, err := loadSQLiteFavorites(tx, owner)
+	if err != nil {
+		return err
+	}
+	history := []models.FavoriteEvent{}
+	if withHistory {
+		if history, err = loadSQLiteEvents(tx, owner); err != nil {
+			return err
+		}
+	}
+
+	// Étape 2 : Appliquer la modification.
+	recorded, err := fn(favorites, history)
+	if err != nil {
+		return err
+	}
+
+	// Étape 3 : Enregistrer le journal puis le résultat.
+	for i := range recorded {
+		recorded[i].Owner = owner
+		if err := insertSQLiteEvent(tx, &recorded[i]); err != nil {
+			return err
+		}
+	}
+	if err := writeSQLiteFavorites(tx, owner, normalizeFavorites(favorites)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Add
+// Ajoute un élément en fin de collection par défaut s'il n'y est pas déjà.
+func (s *SQLiteFavoritesStore) Add(owner, kind, id string) error {
+	return s.Update(owner, func(favorites *models.Favorites) error {
+		return addFavoriteItem(favorites, DefaultFavoritesCollectionID, kind, id)
+	})
+}
+
+// Remove
+// Supprime un élément de toutes les collections d'un propriétaire.
+func (s *SQLiteFavoritesStore) Remove(owner, kind, id string) error {
+	return s.Update(owner, func(favorites *models.Favorites) error {
+		return removeFavoriteItem(favorites, "", kind, id)
+	})
+}
+
+// Delete
+// Supprime tous les favoris d'un propriétaire et son lien de partage.
+func (s *SQLiteFavoritesStore) Delete(owner string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("erreur transaction favoris: %w", err)
+	}
+	defer tx.Rollback() // sans effet après Commit
+
+	if err := deleteSQLiteFavorites(tx, owner); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM favorite_shares WHERE owner = ?`, owner); err != nil {
+		return fmt.Errorf("erreur suppression lien de partage: %w", err)
+	}
+	return tx.Commit()
+}
+
+// SaveShare
+// Enregistre le lien de partage d'un propriétaire (remplace son lien précédent).
+func (s *SQLiteFavoritesStore) SaveShare(share *models.FavoritesShare) error {
+	if share.Owner == "" {
+		return ErrNoFavoritesOwner
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("erreur transaction favoris: %w", err)
+	}
+	defer tx.Rollback() // sans effet après Commit
+
+	if err := saveSQLiteShare(tx, share); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// FindShare
+// Retourne le lien de partage correspondant à un jeton (ErrShareNotFound s'il n'existe pas).
+func (s *SQLiteFavoritesStore) FindShare(token string) (*models.FavoritesShare, error) {
+	return s.findShare(`SELECT token, owner, created_at FROM favorite_shares WHERE token = ?`, token)
+}
+
+// OwnerShare
+// Retourne le lien de partage d'un propriétaire (ErrShareNotFound s'il n'en a pas).
+func (s *SQLiteFavoritesStore) OwnerShare(owner string) (*models.FavoritesShare, error) {
+	return s.findShare(`SELECT token, owner, created_at FROM favorite_shares WHERE owner = ?`, owner)
+}
+
+// DeleteShare
+// Révoque le lien de partage d'un propriétaire.
+func (s *SQLiteFavoritesStore) DeleteShare(owner string) error {
+	if _, err := s.db.Exec(`DELETE FROM favorite_shares WHERE owner = ?`, owner); err != nil {
+		return fmt.Errorf("erreur suppression lien de partage: %w", err)
+	}
+	return nil
+}
+
+// findShare
+// Exécute une requête retournant au plus un lien de partage (ErrShareNotFound si aucun).
+func (s *SQLiteFavoritesStore) findShare(query string, arg string) (*models.FavoritesShare, error) {
+	var share models.FavoritesShare
+	var createdAt string
+	err := s.db.QueryRow(query, arg).Scan(&share.Token, &share.Owner, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShareNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture lien de partage: %w", err)
+	}
+	share.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	return &share, nil
+}
+
+// saveSQLiteShare
+// Remplace, dans la transaction, le lien de partage du propriétaire par celui fourni.
+func saveSQLiteShare(tx *sql.Tx, share *models.FavoritesShare) error {
+	if _, err := tx.Exec(`DELETE FROM favorite_shares WHERE owner = ? OR token = ?`, share.Owner, share.Token); err != nil {
+		return fmt.Errorf("erreur suppression lien de partage: %w", err)
+	}
+	_, err := tx.Exec(`INSERT INTO favorite_shares (token, owner, created_at) VALUES (?, ?, ?)`,
+		share.Token, share.Owner, formatFavoritesTime(share.CreatedAt))
+	if err != nil {
+		return fmt.Errorf("erreur écriture lien de partage: %w", err)
+	}
+	return nil
+}
+
+// loadSQLiteFavorites
+// -------------------
+// Objectif :
+//   - Lire les collections d'un propriétaire puis leurs éléments, chacun dans l'ordre de sa position.
+//   - Retourner des favoris normalisés (collection par défaut présente, identifiants déduits).
+func loadSQLiteFavorites(q favoritesQuerier, owner string) (*models.Favorites, error) {
+
+	// Étape 1 : Lire les collections.
+	rows, err := q.Query(`SELECT id, name, created_at FROM favorite_collections WHERE owner = ? ORDER BY position`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture collections: %w", err)
+	}
+	favorites := &models.Favorites{Collections: []models.FavoriteCollection{}}
+	indexByID := map[string]int{}
+	for rows.Next() {
+		var collection models.FavoriteCollection
+		var createdAt string
+		if err := rows.Scan(&collection.ID, &collection.Name, &createdAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("erreur lecture collections: %w", err)
+		}
+		collection.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+		collection.Items = []models.FavoriteItem{}
+		indexByID[collection.ID] = len(favorites.Collections)
+		favorites.Collections = append(favorites.Collections, collection)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erreur lecture collections: %w", err)
+	}
+
+	// Étape 2 : Lire les éléments et les ranger dans leur collection.
+	rows, err = q.Query(`SELECT collection_id, kind, item_id, previous_id, note, tags, added_at FROM favorite_items
+		WHERE owner = ? ORDER BY position`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture favoris: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var collectionID, tags, addedAt string
+		var item models.FavoriteItem
+		if err := rows.Scan(&collectionID, &item.Kind, &item.ID, &item.PreviousID, &item.Note, &tags, &addedAt); err != nil {
+			return nil, fmt.Errorf("erreur lecture favoris: %w", err)
+		}
+		index, exists := indexByID[collectionID]
+		if !exists {
+			continue
+		}
+		_ = json.Unmarshal([]byte(tags), &item.Tags)
+		item.AddedAt, _ = time.Parse(time.RFC3339, addedAt)
+		favorites.Collections[index].Items = append(favorites.Collections[index].Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erreur lecture favoris: %w", err)
+	}
+	return normalizeFavorites(favorites), nil
+}
+
+// writeSQLiteFavorites
+// Remplace, dans la transaction, les collections et éléments d'un propriétaire par ceux fournis.
+func writeSQLiteFavorites(tx *sql.Tx, owner string, favorites *models.Favorites) error {
+	if err := deleteSQLiteFavorites(tx, owner); err != nil {
+		return err
+	}
+	for position, collection := range favorites.Collections {
+		_, err := tx.Exec(`INSERT INTO favorite_collections (owner, id, name, position, created_at) VALUES (?, ?, ?, ?, ?)`,
+			owner, collection.ID, collection.Name, position, formatFavoritesTime(collection.CreatedAt))
+		if err != nil {
+			return fmt.Errorf("erreur écriture collection: %w", err)
+		}
+		for itemPosition, item := range collection.Items {
+			tags, err := json.Marshal(item.Tags)
+			if err != nil {
+				return fmt.Errorf("erreur encodage tags: %w", err)
+			}
+			_, err = tx.Exec(`INSERT INTO favorite_items (owner, collection_id, kind, item_id, previous_id, position, note, tags, added_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				owner, collection.ID, item.Kind, item.ID, item.PreviousID, itemPosition+1, item.Note, string(tags), formatFavoritesTime(item.AddedAt))
+			if err != nil {
+				return fmt.Errorf("erreur écriture favoris: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// loadSQLiteEvents
+// Lit le journal des favoris d'un propriétaire, dans l'ordre d'enregistrement.
+func loadSQLiteEvents(q favoritesQuerier, owner string) ([]models.FavoriteEvent, error) {
+	rows, err := q.Query(`SELECT id, owner, actor, action, kind, item_id, placements, undoes, snapshot, created_at
+		FROM favorite_events WHERE owner = ? ORDER BY id`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("erreur lecture journal des favoris: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.FavoriteEvent{}
+	for rows.Next() {
+		var event models.FavoriteEvent
+		var placements, snapshot, createdAt string
+		if err := rows.Scan(&event.ID, &event.Owner, &event.Actor, &event.Action, &event.Kind, &event.ItemID,
+			&placements, &event.Undoes, &snapshot, &createdAt); err != nil {
+			return nil, fmt.Errorf("erreur lecture journal des favoris: %w", err)
+		}
+		_ = json.Unmarshal([]byte(placements), &event.Placements)
+		if snapshot != "" {
+			event.Snapshot = &models.Favorites{}
+			if err := json.Unmarshal([]byte(snapshot), event.Snapshot); err != nil {
+				return nil, fmt.Errorf("erreur décodage état de référence %d: %w", event.ID, err)
+			}
+		}
+		event.At, _ = time.Parse(time.RFC3339, createdAt)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("erreur lecture journal des favoris: %w", err)
+	}
+	return events, nil
+}
+
+// insertSQLiteEvent
+// Ajoute, dans la transaction, un événement au journal et renseigne son identifiant.
+func insertSQLiteEvent(tx *sql.Tx, event *models.FavoriteEvent) error {
+	placements, err := json.Marshal(event.Placements)
+	if err != nil {
+		return fmt.Errorf("erreur encodage journal des favoris: %w", err)
+	}
+	snapshot := []byte{}
+	if event.Snapshot != nil {
+		if snapshot, err = json.Marshal(event.Snapshot); err != nil {
+			return fmt.Errorf("erreur encodage journal des favoris: %w", err)
+		}
+	}
+	result, err := tx.Exec(`INSERT INTO favorite_events (owner, actor, action, kind, item_id, placements, undoes, snapshot, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.Owner, event.Actor, event.Action, event.Kind, event.ItemID, string(placements), event.Undoes, string(snapshot), formatFavoritesTime(event.At))
+	if err != nil {
+		return fmt.Errorf("erreur écriture journal des favoris: %w", err)
+	}
+	event.ID, err = result.LastInsertId()
+	return err
+}
+
+// deleteSQLiteFavorites
+// Supprime, dans la transaction, les collections et éléments d'un propriétaire.
+func deleteSQLiteFavorites(tx *sql.Tx, owner string) error {
+	if _, err := tx.Exec(`DELETE FROM favorite_items WHERE owner = ?`, owner); err != nil {
+		return fmt.Errorf("erreur suppression favoris: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM favorite_collections WHERE owner = ?`, owner); err != nil {
+		return fmt.Errorf("erreur suppression collections: %w", err)
+	}
+	return nil
+}
+
+// formatFavoritesTime
+// Formate une date pour la base (RFC 3339 UTC, chaîne vide si la date est inconnue).
+func formatFavoritesTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Close
+// Ferme la base.
+func (s *SQLiteFavoritesStore) Close() error {
+	return s.db.Close()
+}
+
+// ImportJSON
+// ----------
+// Objectif :
+//   - Importer une seule fois les favoris d'un fichier favorites.json existant (marqueur dans store_meta).
+//   - Fusionner les collections avec celles déjà présentes, sans doublon, et reprendre les liens de partage
+//     et le journal des modifications (favorites.events.jsonl), dans une transaction.
+//   - Ne rien faire si l'import a déjà eu lieu ou si le fichier n'existe pas ; le fichier n'est pas modifié.
+//   - Retourner vrai si un import a été effectué.
+func (s *SQLiteFavoritesStore) ImportJSON(path string) (bool, error) {
+
+	// Étape 1 : Ne rien faire si le fichier JSON n'existe pas.
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	}
+
+	// Étape 2 : Vérifier dans la transaction que l'import n'a pas déjà eu lieu (autre processus compris).
+	tx, err := s.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback() // sans effet après Commit
+
+	var done string
+	err = tx.QueryRow(`SELECT value FROM store_meta WHERE key = ?`, favoritesJSONImportKey).Scan(&done)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("erreur lecture métadonnées favoris: %w", err)
+	}
+
+	// Étape 3 : Lire le fichier, insérer les favoris de chaque propriétaire et enregistrer le marqueur.
+	file, err := readFavoritesFile(path)
+	if err != nil {
+		return false, err
+	}
+	for owner, favorites := range file.Owners {
+		if err := importFavorites(tx, owner, favorites); err != nil {
+			return false, err
+		}
+	}
+	for i := range file.Shares {
+		if err := saveSQLiteShare(tx, &file.Shares[i]); err != nil {
+			return false, err
+		}
+	}
+	events, _, err := readFavoriteEvents(favoritesEventsPath(path))
+	if err != nil {
+		return false, err
+	}
+	newIDs := map[int64]int64{} // les événements sont renumérotés : Undoes suit la nouvelle numérotation
+	for i := range events {
+		oldID := events[i].ID
+		events[i].Undoes = newIDs[events[i].Undoes]
+		if err := insertSQLiteEvent(tx, &events[i]); err != nil {
+			return false, err
+		}
+		newIDs[oldID] = events[i].ID
+	}
+	marker := fmt.Sprintf("%s (%s)", path, time.Now().UTC().Format(time.RFC3339))
+	if _, err := tx.Exec(`INSERT INTO store_meta (key, value) VALUES (?, ?)`, favoritesJSONImportKey, marker); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	fmt.Printf("Favoris importés depuis %s (%d propriétaires)\n", path, len(file.Owners))
+	return true, nil
+}
+
+// importFavorites
+// Fusionne, dans la transaction, des favoris importés avec ceux d'un propriétaire (doublons ignorés).
+func importFavorites(tx *sql.Tx, owner string, imported *models.Favorites) error {
+	favorites, err := loadSQLiteFavorites(tx, owner)
+	if err != nil {
+		return err
+	}
+	mergeFavoriteCollections(favorites, imported)
+	return writeSQLiteFavorites(tx, owner, normalizeFavorites(favorites))
+}
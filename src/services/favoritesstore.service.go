@@ -0,0 +1,100 @@
+package services
+
+import (
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"path/filepath"
+)
+
+// Types d'éléments pouvant être ajoutés aux favoris.
+const (
+	FavoriteDriver      = "driver"
+	FavoriteConstructor = "constructor"
+)
+
+// Backends de stockage des favoris sélectionnables par configuration (F1_FAVORITES_STORE).
+const (
+	FavoritesStoreJSON   = "json"
+	FavoritesStoreSQLite = "sqlite"
+)
+
+// SharedFavoritesOwner est le propriétaire des favoris globaux enregistrés avant l'arrivée des comptes
+// (adoptés par le premier compte créé).
+const SharedFavoritesOwner = "shared"
+
+// ErrInvalidFavoriteKind signale un type d'élément inconnu (ni pilote ni écurie).
+var ErrInvalidFavoriteKind = errors.New("type de favori invalide")
+
+// ErrNoFavoritesOwner signale une modification de favoris sans propriétaire (visiteur non identifié).
+var ErrNoFavoritesOwner = errors.New("aucun propriétaire pour les favoris")
+
+// ErrShareNotFound signale un lien de partage inconnu ou révoqué.
+var ErrShareNotFound = errors.New("lien de partage introuvable")
+
+// FavoritesStore
+// Interface d'un stockage des favoris (fichier JSON, base SQLite), indexés par propriétaire.
+// Les modifications sont sérialisées : Update applique fn aux favoris courants et enregistre le résultat
+// sans qu'une autre écriture (même d'un autre processus) puisse s'intercaler ; une erreur de fn annule tout.
+// Record fait de même en donnant à fn le journal du propriétaire, et ajoute au journal (en ajout seul, jamais
+// modifié ni purgé par Delete) les événements retournés dans la même écriture ; Events relit ce journal.
+// Le stockage conserve aussi le lien de partage de chaque propriétaire (SaveShare remplace le précédent,
+// Delete le supprime avec les favoris).
+type FavoritesStore interface {
+	Load(owner string) (*models.Favorites, error)
+	Save(owner string, favorites *models.Favorites) error
+	Update(owner string, fn func(favorites *models.Favorites) error) error
+	Record(owner string, fn func(favorites *models.Favorites, history []models.FavoriteEvent) ([]models.FavoriteEvent, error)) error
+	Events(owner string) ([]models.FavoriteEvent, error)
+	Add(owner, kind, id string) error
+	Remove(owner, kind, id string) error
+	Delete(owner string) error
+	SaveShare(share *models.FavoritesShare) error
+	FindShare(token string) (*models.FavoritesShare, error)
+	OwnerShare(owner string) (*models.FavoritesShare, error)
+	DeleteShare(owner string) error
+}
+
+// favoritesStore est le stockage utilisé par les services de favoris (fichier JSON par défaut).
+var favoritesStore FavoritesStore = NewJSONFavoritesStore("")
+
+// UseFavoritesStore
+// Remplace le stockage des favoris (appelé au démarrage selon la configuration).
+func UseFavoritesStore(store FavoritesStore) {
+	favoritesStore = store
+}
+
+// OpenFavoritesStore
+// ------------------
+// Objectif :
+//   - Ouvrir le stockage demandé : "json" (par défaut) ou "sqlite".
+//   - Utiliser path s'il est fourni, sinon favorites.json / favorites.db à la racine du projet.
+//   - Pour SQLite : appliquer les migrations puis importer une seule fois le fichier favorites.json existant
+//     (les comptes et sessions sont alors enregistrés dans la même base).
+func OpenFavoritesStore(kind, path string) (FavoritesStore, error) {
+	switch kind {
+	case "", FavoritesStoreJSON:
+		return NewJSONFavoritesStore(path), nil
+	case FavoritesStoreSQLite:
+		if path == "" {
+			path = filepath.Join(filepath.Dir(GetFavoritesFilePath()), favoritesDBFileName)
+		}
+		store, err := OpenSQLiteFavoritesStore(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := store.ImportJSON(GetFavoritesFilePath()); err != nil {
+			store.Close()
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("stockage des favoris inconnu : %q (json ou sqlite)", kind)
+	}
+}
+
+// emptyFavorites
+// Retourne des favoris vides (collection par défaut seule, listes non nil).
+func emptyFavorites() *models.Favorites {
+	return normalizeFavorites(&models.Favorites{})
+}
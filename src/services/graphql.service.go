@@ -0,0 +1,848 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// GraphQLMaxDepth est la profondeur maximale d'une requête GraphQL (les relations pilote/écurie sont cycliques).
+const GraphQLMaxDepth = 6
+
+// Erreurs des mutations refusées : envoyée en GET, ou en POST sans le jeton CSRF dans l'en-tête X-CSRF-Token.
+var (
+	ErrGraphQLMutationNotAllowed = errors.New("les mutations GraphQL doivent être envoyées en POST")
+	ErrGraphQLMutationCSRF       = errors.New("les mutations GraphQL exigent le jeton CSRF (cookie f1_csrf) dans l'en-tête X-CSRF-Token")
+)
+
+// graphQLDriver
+// Pilote résolu par GraphQL, accompagné de la saison pour résoudre ses relations.
+type graphQLDriver struct {
+	driver models.Driver
+	season string
+}
+
+// graphQLConstructor
+// Écurie résolue par GraphQL, accompagnée de la saison pour résoudre ses relations.
+type graphQLConstructor struct {
+	constructor models.Constructor
+	season      string
+}
+
+// graphQLState
+// État propre à une requête : favoris chargés une seule fois (rechargés après une mutation).
+type graphQLState struct {
+	mu        sync.Mutex
+	favorites *models.Favorites
+}
+
+// graphQLStateKey est la clé de l'état de requête dans le contexte d'exécution.
+type graphQLStateKey struct{}
+
+var (
+	graphQLSchemaOnce sync.Once
+	graphQLSchema     graphql.Schema
+	graphQLSchemaErr  error
+)
+
+// ExecuteGraphQL
+// --------------
+// Objectif :
+//   - Analyser la requête et refuser celles dont la profondeur dépasse GraphQLMaxDepth.
+//   - Refuser les mutations si mutationRefusal est renseigné (405 pour une requête GET, 403 sans jeton CSRF).
+//   - Exécuter la requête sur le schéma (résolu via les services existants) et convertir la réponse.
+//   - Retourner 400 pour une requête invalide (aucune donnée), 200 sinon (erreurs partielles dans "errors").
+func ExecuteGraphQL(ctx context.Context, request models.GraphQLRequest, mutationRefusal error) (*models.GraphQLResponse, int) {
+
+	// Étape 1 : Construire le schéma au premier appel.
+	schema, err := getGraphQLSchema()
+	if err != nil {
+		return graphQLErrorResponse(err), http.StatusInternalServerError
+	}
+
+	// Étape 2 : Analyser la requête, vérifier sa profondeur et le type d'opération.
+	if strings.TrimSpace(request.Query) == "" {
+		return graphQLErrorResponse(errors.New("paramètre query manquant")), http.StatusBadRequest
+	}
+	document, err := parser.Parse(parser.ParseParams{Source: request.Query})
+	if err != nil {
+		return &models.GraphQLResponse{Errors: convertGraphQLErrors([]gqlerrors.FormattedError{gqlerrors.FormatError(err)})}, http.StatusBadRequest
+	}
+	if depth := graphQLQueryDepth(document); depth > GraphQLMaxDepth {
+		return graphQLErrorResponse(fmt.Errorf("profondeur de requête %d supérieure au maximum autorisé (%d)", depth, GraphQLMaxDepth)), http.StatusBadRequest
+	}
+	if mutationRefusal != nil && graphQLOperation(document, request.OperationName) == ast.OperationTypeMutation {
+		if errors.Is(mutationRefusal, ErrGraphQLMutationCSRF) {
+			return graphQLErrorResponse(mutationRefusal), http.StatusForbidden
+		}
+		return graphQLErrorResponse(mutationRefusal), http.StatusMethodNotAllowed
+	}
+
+	// Étape 3 : Exécuter la requête avec un état propre à la requête.
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        context.WithValue(ctx, graphQLStateKey{}, &graphQLState{}),
+	})
+
+	// Étape 4 : Convertir la réponse (sans données : requête invalide).
+	response := &models.GraphQLResponse{Data: result.Data, Errors: convertGraphQLErrors(result.Errors)}
+	if result.Data == nil && len(result.Errors) > 0 {
+		return response, http.StatusBadRequest
+	}
+	return response, http.StatusOK
+}
+
+// graphQLErrorResponse
+// Construit une réponse GraphQL ne contenant qu'une erreur.
+func graphQLErrorResponse(err error) *models.GraphQLResponse {
+	return &models.GraphQLResponse{Errors: []models.GraphQLError{{Message: err.Error()}}}
+}
+
+// convertGraphQLErrors
+// Convertit les erreurs de la bibliothèque GraphQL en erreurs du modèle de réponse.
+func convertGraphQLErrors(formatted []gqlerrors.FormattedError) []models.GraphQLError {
+	if len(formatted) == 0 {
+		return nil
+	}
+	converted := make([]models.GraphQLError, 0, len(formatted))
+	for _, err := range formatted {
+		locations := []models.GraphQLLocation{}
+		for _, location := range err.Locations {
+			locations = append(locations, models.GraphQLLocation{Line: location.Line, Column: location.Column})
+		}
+		converted = append(converted, models.GraphQLError{Message: err.Message, Locations: locations, Path: err.Path})
+	}
+	return converted
+}
+
+// graphQLOperation
+// Retourne le type (query, mutation...) de l'opération exécutée (nommée, ou la seule du document).
+func graphQLOperation(document *ast.Document, operationName string) string {
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName == "" || (operation.Name != nil && operation.Name.Value == operationName) {
+			return operation.Operation
+		}
+	}
+	return ""
+}
+
+// graphQLQueryDepth
+// -----------------
+// Objectif :
+//   - Calculer la profondeur maximale des opérations d'un document (un champ sans sous-sélection compte 1).
+//   - Suivre les fragments nommés et en ligne (un cycle de fragments est arrêté, la validation le signalera).
+//   - Ignorer seulement __typename (sans sous-sélection) : l'introspection (__schema, __type) compte comme les autres champs,
+//     sans quoi une introspection imbriquée échapperait à la limite.
+func graphQLQueryDepth(document *ast.Document) int {
+	fragments := map[string]*ast.SelectionSet{}
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			fragments[fragment.Name.Value] = fragment.SelectionSet
+		}
+	}
+
+	var depthOf func(set *ast.SelectionSet, visiting map[string]bool) int
+	depthOf = func(set *ast.SelectionSet, visiting map[string]bool) int {
+		if set == nil {
+			return 0
+		}
+		deepest := 0
+		for _, selection := range set.Selections {
+			depth := 0
+			switch node := selection.(type) {
+			case *ast.Field:
+				if node.Name != nil && node.Name.Value == "__typename" {
+					continue
+				}
+				depth = 1 + depthOf(node.SelectionSet, visiting)
+			case *ast.InlineFragment:
+				depth = depthOf(node.SelectionSet, visiting)
+			case *ast.FragmentSpread:
+				name := node.Name.Value
+				if visiting[name] {
+					continue
+				}
+				visiting[name] = true
+				depth = depthOf(fragments[name], visiting)
+				delete(visiting, name)
+			}
+			if depth > deepest {
+				deepest = depth
+			}
+		}
+		return deepest
+	}
+
+	deepest := 0
+	for _, definition := range document.Definitions {
+		if operation, ok := definition.(*ast.OperationDefinition); ok {
+			if depth := depthOf(operation.SelectionSet, map[string]bool{}); depth > deepest {
+				deepest = depth
+			}
+		}
+	}
+	return deepest
+}
+
+// getGraphQLSchema
+// Construit le schéma GraphQL une seule fois et le retourne.
+func getGraphQLSchema() (graphql.Schema, error) {
+	graphQLSchemaOnce.Do(func() {
+		graphQLSchema, graphQLSchemaErr = buildGraphQLSchema()
+	})
+	return graphQLSchema, graphQLSchemaErr
+}
+
+// buildGraphQLSchema
+// ------------------
+// Objectif :
+//   - Déclarer les types Driver, Constructor, DriverPage, ConstructorPage, Pagination, SearchResult, FavoriteList et ses collections.
+//   - Déclarer les requêtes drivers, driver, constructors, constructor, search et favorites.
+//   - Déclarer les mutations d'ajout et de suppression des favoris.
+//   - Résoudre chaque champ via les services existants (QueryDrivers, GetDriverService, favoris...).
+func buildGraphQLSchema() (graphql.Schema, error) {
+
+	// Étape 1 : Types scalaires composés et énumérations.
+	driverSortValues := graphql.EnumValueConfigMap{}
+	for _, option := range DriverSortOptions {
+		driverSortValues[strings.ToUpper(option.Value)] = &graphql.EnumValueConfig{Value: option.Value, Description: option.Label}
+	}
+	driverSortEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "DriverSort",
+		Description: "Order of the driver list (DOB: date of birth).",
+		Values:      driverSortValues,
+	})
+	constructorSortValues := graphql.EnumValueConfigMap{}
+	for _, option := range ConstructorSortOptions {
+		constructorSortValues[strings.ToUpper(option.Value)] = &graphql.EnumValueConfig{Value: option.Value, Description: option.Label}
+	}
+	constructorSortEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "ConstructorSort",
+		Description: "Order of the constructor list (DRIVERS: roster size).",
+		Values:      constructorSortValues,
+	})
+	orderEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "Order",
+		Description: "Sort direction (missing values always come last).",
+		Values: graphql.EnumValueConfigMap{
+			"ASC":  &graphql.EnumValueConfig{Value: OrderAsc},
+			"DESC": &graphql.EnumValueConfig{Value: OrderDesc},
+		},
+	})
+	paginationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Pagination",
+		Fields: graphql.Fields{
+			"page":       paginationField(func(p models.Pagination) int { return p.Page }),
+			"perPage":    paginationField(func(p models.Pagination) int { return p.PerPage }),
+			"totalPages": paginationField(func(p models.Pagination) int { return p.TotalPages }),
+			"total":      paginationField(func(p models.Pagination) int { return p.Total }),
+			"startIndex": paginationField(func(p models.Pagination) int { return p.StartIndex }),
+			"endIndex":   paginationField(func(p models.Pagination) int { return p.EndIndex }),
+			"nextCursor": paginationCursorField("Cursor of the next page (null on the last page).", func(p models.Pagination) string { return p.NextCursor }),
+			"prevCursor": paginationCursorField("Cursor of the previous page (null on the first page).", func(p models.Pagination) string { return p.PrevCursor }),
+		},
+	})
+	// Un filtre accepte une liste de valeurs ou une valeur seule (convertie en liste par GraphQL).
+	stringListType := graphql.NewList(graphql.NewNonNull(graphql.String))
+	driverFilterInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "DriverFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"team":        &graphql.InputObjectFieldConfig{Type: stringListType, Description: "Exact team names (any of them)."},
+			"nationality": &graphql.InputObjectFieldConfig{Type: stringListType, Description: "Exact nationalities (any of them)."},
+			"driverType":  &graphql.InputObjectFieldConfig{Type: stringListType, Description: "Driver types (any of them)."},
+			"ageMin":      &graphql.InputObjectFieldConfig{Type: graphql.Int, Description: "Minimum age (at the end of a past season, today otherwise)."},
+			"ageMax":      &graphql.InputObjectFieldConfig{Type: graphql.Int, Description: "Maximum age."},
+			"decade":      &graphql.InputObjectFieldConfig{Type: stringListType, Description: "Birth decades, e.g. \"1990s\" (any of them)."},
+			"rookie":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "Only drivers absent from (true) or present in (false) the previous season."},
+		},
+	})
+	constructorFilterInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ConstructorFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"nationality": &graphql.InputObjectFieldConfig{Type: stringListType, Description: "Exact nationalities (any of them)."},
+			"engine":      &graphql.InputObjectFieldConfig{Type: stringListType, Description: "Exact engine suppliers (any of them)."},
+		},
+	})
+
+	// Étape 2 : Types Driver et Constructor (références croisées résolues à la demande).
+	var driverType, constructorType *graphql.Object
+	driverType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Driver",
+		Description: "Formula 1 driver of a season.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"driverId":        driverField(graphql.NewNonNull(graphql.ID), func(d models.Driver) string { return d.DriverID }),
+				"permanentNumber": driverField(graphql.String, func(d models.Driver) string { return d.PermanentNumber }),
+				"code":            driverField(graphql.String, func(d models.Driver) string { return d.Code }),
+				"url":             driverField(graphql.String, func(d models.Driver) string { return d.URL }),
+				"image":           driverField(graphql.String, func(d models.Driver) string { return d.Image }),
+				"givenName":       driverField(graphql.NewNonNull(graphql.String), func(d models.Driver) string { return d.GivenName }),
+				"familyName":      driverField(graphql.NewNonNull(graphql.String), func(d models.Driver) string { return d.FamilyName }),
+				"dateOfBirth":     driverField(graphql.String, func(d models.Driver) string { return d.DateOfBirth }),
+				"nationality":     driverField(graphql.String, func(d models.Driver) string { return d.Nationality }),
+				"team":            driverField(graphql.String, func(d models.Driver) string { return d.Team }),
+				"constructorId":   driverField(graphql.String, func(d models.Driver) string { return d.ConstructorID }),
+				"driverType":      driverField(graphql.String, func(d models.Driver) string { return d.DriverType }),
+				"constructor": &graphql.Field{
+					Type: constructorType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						source := p.Source.(graphQLDriver)
+						_, team, _, err := GetDriverService(source.season, source.driver.DriverID)
+						if err != nil || team == nil {
+							return nil, err
+						}
+						return graphQLConstructor{constructor: *team, season: source.season}, nil
+					},
+				},
+				"isFavorite": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Boolean),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						favorites, err := graphQLFavorites(p.Context)
+						if err != nil {
+							return false, err
+						}
+						return containsID(favorites.Drivers, p.Source.(graphQLDriver).driver.DriverID), nil
+					},
+				},
+			}
+		}),
+	})
+	constructorType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Constructor",
+		Description: "Formula 1 constructor (team) of a season.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"constructorId": constructorField(graphql.NewNonNull(graphql.ID), func(c models.Constructor) string { return c.ConstructorID }),
+				"name":          constructorField(graphql.NewNonNull(graphql.String), func(c models.Constructor) string { return c.Name }),
+				"nationality":   constructorField(graphql.String, func(c models.Constructor) string { return c.Nationality }),
+				"engine":        constructorField(graphql.String, func(c models.Constructor) string { return c.Engine }),
+				"url":           constructorField(graphql.String, func(c models.Constructor) string { return c.URL }),
+				"icon":          constructorField(graphql.String, func(c models.Constructor) string { return c.Icon }),
+				"image":         constructorField(graphql.String, func(c models.Constructor) string { return c.Image }),
+				"teamColor":     constructorField(graphql.String, func(c models.Constructor) string { return c.TeamColor }),
+				"drivers": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(driverType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						source := p.Source.(graphQLConstructor)
+						_, drivers, _, err := GetConstructorService(source.season, source.constructor.ConstructorID)
+						if err != nil {
+							return nil, err
+						}
+						return wrapGraphQLDrivers(drivers, source.season), nil
+					},
+				},
+				"isFavorite": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Boolean),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						favorites, err := graphQLFavorites(p.Context)
+						if err != nil {
+							return false, err
+						}
+						return containsID(favorites.Constructors, p.Source.(graphQLConstructor).constructor.ConstructorID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	// Étape 3 : Types de résultats (pages de pilotes et d'écuries, recherche, favoris).
+	driverList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(driverType)))
+	constructorList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(constructorType)))
+	idList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID)))
+
+	driverPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "DriverPage",
+		Fields: graphql.Fields{
+			"season":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"sort":       &graphql.Field{Type: graphql.NewNonNull(driverSortEnum)},
+			"order":      &graphql.Field{Type: graphql.NewNonNull(orderEnum)},
+			"drivers":    &graphql.Field{Type: driverList},
+			"pagination": &graphql.Field{Type: graphql.NewNonNull(paginationType)},
+		},
+	})
+	constructorPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ConstructorPage",
+		Fields: graphql.Fields{
+			"season":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"sort":         &graphql.Field{Type: graphql.NewNonNull(constructorSortEnum)},
+			"order":        &graphql.Field{Type: graphql.NewNonNull(orderEnum)},
+			"constructors": &graphql.Field{Type: constructorList},
+			"pagination":   &graphql.Field{Type: graphql.NewNonNull(paginationType)},
+		},
+	})
+	searchResultType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SearchResult",
+		Fields: graphql.Fields{
+			"drivers":      &graphql.Field{Type: driverList},
+			"constructors": &graphql.Field{Type: constructorList},
+		},
+	})
+	favoriteItemType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "FavoriteItem",
+		Description: "Driver or constructor saved in a collection, with its note, tags and date added.",
+		Fields: graphql.Fields{
+			"kind": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"id":   &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"previousId": &graphql.Field{
+				Type:        graphql.ID,
+				Description: "Former identifier of a renamed constructor, remapped automatically (null if never renamed).",
+			},
+			"note":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"tags":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+			"addedAt": &graphql.Field{Type: graphql.String, Description: "RFC 3339 date (null if unknown)."},
+		},
+	})
+	favoriteCollectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "FavoriteCollection",
+		Description: "Named collection of favorites, in the order chosen by the visitor.",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"name":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt": &graphql.Field{Type: graphql.String, Description: "RFC 3339 date (null if unknown)."},
+			"items":     &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(favoriteItemType)))},
+		},
+	})
+	favoriteListType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "FavoriteList",
+		Description: "Saved favorites: every identifier, the collections, and the matching drivers and constructors of the season.",
+		Fields: graphql.Fields{
+			"driverIds":      &graphql.Field{Type: idList},
+			"constructorIds": &graphql.Field{Type: idList},
+			"collections":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(favoriteCollectionType)))},
+			"drivers":        &graphql.Field{Type: driverList},
+			"constructors":   &graphql.Field{Type: constructorList},
+			"orphans": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(favoriteItemType))),
+				Description: "Saved items that no longer exist in any season (no longer on the grid).",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					favorites := p.Source.(map[string]interface{})["favorites"].(*models.Favorites)
+					orphans, err := FindOrphanedFavorites(favorites)
+					if err != nil {
+						return nil, err
+					}
+					return wrapGraphQLItems(orphans), nil
+				},
+			},
+		},
+	})
+
+	seasonArg := &graphql.ArgumentConfig{Type: graphql.String, Description: "Season year (defaults to " + DefaultSeason + ")."}
+	idArg := &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}
+
+	// Étape 4 : Requêtes.
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"drivers": &graphql.Field{
+				Type:        graphql.NewNonNull(driverPageType),
+				Description: "Filtered and paginated drivers (same rules as /api/v1/drivers).",
+				Args: graphql.FieldConfigArgument{
+					"season":  seasonArg,
+					"filter":  &graphql.ArgumentConfig{Type: driverFilterInput},
+					"sort":    &graphql.ArgumentConfig{Type: driverSortEnum},
+					"order":   &graphql.ArgumentConfig{Type: orderEnum},
+					"page":    &graphql.ArgumentConfig{Type: graphql.Int},
+					"perPage": &graphql.ArgumentConfig{Type: graphql.Int},
+					"cursor":  &graphql.ArgumentConfig{Type: graphql.String, Description: "Opaque cursor from pagination.nextCursor or prevCursor (replaces page)."},
+				},
+				Resolve: resolveGraphQLDrivers,
+			},
+			"driver": &graphql.Field{
+				Type: driverType,
+				Args: graphql.FieldConfigArgument{"id": idArg, "season": seasonArg},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					season := graphQLSeason(p)
+					driver, _, _, err := GetDriverService(season, p.Args["id"].(string))
+					if errors.Is(err, ErrDriverNotFound) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, err
+					}
+					return graphQLDriver{driver: *driver, season: season}, nil
+				},
+			},
+			"constructors": &graphql.Field{
+				Type:        graphql.NewNonNull(constructorPageType),
+				Description: "Filtered and paginated constructors (same rules as /api/v1/constructors).",
+				Args: graphql.FieldConfigArgument{
+					"season":  seasonArg,
+					"filter":  &graphql.ArgumentConfig{Type: constructorFilterInput},
+					"sort":    &graphql.ArgumentConfig{Type: constructorSortEnum},
+					"order":   &graphql.ArgumentConfig{Type: orderEnum},
+					"page":    &graphql.ArgumentConfig{Type: graphql.Int},
+					"perPage": &graphql.ArgumentConfig{Type: graphql.Int},
+					"cursor":  &graphql.ArgumentConfig{Type: graphql.String, Description: "Opaque cursor from pagination.nextCursor or prevCursor (replaces page)."},
+				},
+				Resolve: resolveGraphQLConstructors,
+			},
+			"constructor": &graphql.Field{
+				Type: constructorType,
+				Args: graphql.FieldConfigArgument{"id": idArg, "season": seasonArg},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					season := graphQLSeason(p)
+					team, _, _, err := GetConstructorService(season, p.Args["id"].(string))
+					if errors.Is(err, ErrConstructorNotFound) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, err
+					}
+					return graphQLConstructor{constructor: *team, season: season}, nil
+				},
+			},
+			"search": &graphql.Field{
+				Type: graphql.NewNonNull(searchResultType),
+				Args: graphql.FieldConfigArgument{"q": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}, "season": seasonArg},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					season := graphQLSeason(p)
+					drivers, constructors, _, err := GetSearchService(season, p.Args["q"].(string))
+					if err != nil {
+						return nil, err
+					}
+					return map[string]interface{}{
+						"drivers":      wrapGraphQLDrivers(drivers, season),
+						"constructors": wrapGraphQLConstructors(constructors, season),
+					}, nil
+				},
+			},
+			"favorites": &graphql.Field{
+				Type:    graphql.NewNonNull(favoriteListType),
+				Args:    graphql.FieldConfigArgument{"season": seasonArg},
+				Resolve: resolveGraphQLFavorites,
+			},
+		},
+	})
+
+	// Étape 5 : Mutations des favoris (retournent la liste à jour).
+	mutationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"addFavoriteDriver":         favoriteMutation(favoriteListType, "driver", AddDriverToFavorites),
+			"removeFavoriteDriver":      favoriteMutation(favoriteListType, "driver", RemoveDriverFromFavorites),
+			"addFavoriteConstructor":    favoriteMutation(favoriteListType, "constructor", AddConstructorToFavorites),
+			"removeFavoriteConstructor": favoriteMutation(favoriteListType, "constructor", RemoveConstructorFromFavorites),
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType, Mutation: mutationType})
+}
+
+// resolveGraphQLDrivers
+// Résout drivers(season, filter, sort, order, page, perPage, cursor) avec QueryDrivers.
+func resolveGraphQLDrivers(p graphql.ResolveParams) (interface{}, error) {
+	season := graphQLSeason(p)
+	filters := models.DriverFilters{}
+	if filter, ok := p.Args["filter"].(map[string]interface{}); ok {
+		filters.Team = graphQLStrings(filter["team"])
+		filters.Nationality = graphQLStrings(filter["nationality"])
+		filters.DriverType = graphQLStrings(filter["driverType"])
+		filters.Decade = graphQLStrings(filter["decade"])
+		filters.AgeMin, _ = filter["ageMin"].(int)
+		filters.AgeMax, _ = filter["ageMax"].(int)
+		if rookie, ok := filter["rookie"].(bool); ok {
+			filters.Rookie = []string{strconv.FormatBool(rookie)}
+		}
+	}
+	sortParam, _ := p.Args["sort"].(string)
+	orderParam, _ := p.Args["order"].(string)
+
+	result, _, err := QueryDrivers(season, filters, sortParam, orderParam, graphQLPageRequest(p))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"season":     season,
+		"sort":       result.Sort,
+		"order":      result.Order,
+		"drivers":    wrapGraphQLDrivers(result.Drivers, season),
+		"pagination": result.Pagination,
+	}, nil
+}
+
+// resolveGraphQLConstructors
+// Résout constructors(season, filter, sort, order, page, perPage, cursor) avec QueryConstructors.
+func resolveGraphQLConstructors(p graphql.ResolveParams) (interface{}, error) {
+	season := graphQLSeason(p)
+	filters := models.ConstructorFilters{}
+	if filter, ok := p.Args["filter"].(map[string]interface{}); ok {
+		filters.Nationality = graphQLStrings(filter["nationality"])
+		filters.Engine = graphQLStrings(filter["engine"])
+	}
+	sortParam, _ := p.Args["sort"].(string)
+	orderParam, _ := p.Args["order"].(string)
+
+	result, _, err := QueryConstructors(season, filters, sortParam, orderParam, graphQLPageRequest(p))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"season":       season,
+		"sort":         result.Sort,
+		"order":        result.Order,
+		"constructors": wrapGraphQLConstructors(result.Constructors, season),
+		"pagination":   result.Pagination,
+	}, nil
+}
+
+// resolveGraphQLFavorites
+// Résout favorites(season) avec GetFavoritesService, pour le visiteur de la requête.
+func resolveGraphQLFavorites(p graphql.ResolveParams) (interface{}, error) {
+	season := graphQLSeason(p)
+	owner := FavoritesOwner(ViewerFromContext(p.Context))
+	favorites, drivers, constructors, _, err := GetFavoritesService(owner, season)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"favorites":      favorites,
+		"driverIds":      favorites.Drivers,
+		"constructorIds": favorites.Constructors,
+		"collections":    wrapGraphQLCollections(favorites.Collections),
+		"drivers":        wrapGraphQLDrivers(drivers, season),
+		"constructors":   wrapGraphQLConstructors(constructors, season),
+	}, nil
+}
+
+// wrapGraphQLCollections
+// Convertit les collections de favoris en valeurs GraphQL (dates au format RFC 3339, nil si inconnues).
+func wrapGraphQLCollections(collections []models.FavoriteCollection) []map[string]interface{} {
+	wrapped := make([]map[string]interface{}, 0, len(collections))
+	for _, collection := range collections {
+		wrapped = append(wrapped, map[string]interface{}{
+			"id":        collection.ID,
+			"name":      collection.Name,
+			"createdAt": graphQLTime(collection.CreatedAt),
+			"items":     wrapGraphQLItems(collection.Items),
+		})
+	}
+	return wrapped
+}
+
+// wrapGraphQLItems
+// Convertit des éléments de favoris en valeurs GraphQL (ancien identifiant et date nil s'ils sont inconnus).
+func wrapGraphQLItems(items []models.FavoriteItem) []map[string]interface{} {
+	wrapped := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		var previousID interface{}
+		if item.PreviousID != "" {
+			previousID = item.PreviousID
+		}
+		wrapped = append(wrapped, map[string]interface{}{
+			"kind":       item.Kind,
+			"id":         item.ID,
+			"previousId": previousID,
+			"note":       item.Note,
+			"tags":       item.Tags,
+			"addedAt":    graphQLTime(item.AddedAt),
+		})
+	}
+	return wrapped
+}
+
+// graphQLTime
+// Formate une date au format RFC 3339 (nil si elle est inconnue).
+func graphQLTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// favoriteMutation
+// ----------------
+// Objectif :
+//   - Déclarer une mutation de favori (id, season) pour un pilote ou une écurie.
+//   - Vérifier que l'élément existe dans la saison avant de l'ajouter (la suppression est toujours permise).
+//   - Modifier les favoris du visiteur de la requête (compte connecté ou cookie anonyme).
+//   - Retourner la liste des favoris à jour.
+func favoriteMutation(favoriteListType *graphql.Object, itemType string, apply func(owner, id string) error) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(favoriteListType),
+		Args: graphql.FieldConfigArgument{
+			"id":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"season": &graphql.ArgumentConfig{Type: graphql.String},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id := p.Args["id"].(string)
+			season := graphQLSeason(p)
+
+			// Étape 1 : Vérifier l'existence de l'élément pour un ajout.
+			if strings.HasPrefix(p.Info.FieldName, "add") {
+				var err error
+				if itemType == "driver" {
+					_, _, _, err = GetDriverService(season, id)
+				} else {
+					_, _, _, err = GetConstructorService(season, id)
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// Étape 2 : Appliquer la modification et invalider les favoris en cache.
+			if err := apply(FavoritesOwner(ViewerFromContext(p.Context)), id); err != nil {
+				return nil, err
+			}
+			if state, ok := p.Context.Value(graphQLStateKey{}).(*graphQLState); ok {
+				state.mu.Lock()
+				state.favorites = nil
+				state.mu.Unlock()
+			}
+			return resolveGraphQLFavorites(p)
+		},
+	}
+}
+
+// graphQLFavorites
+// Retourne les favoris du visiteur de la requête en cours (chargés au premier accès).
+func graphQLFavorites(ctx context.Context) (*models.Favorites, error) {
+	owner := FavoritesOwner(ViewerFromContext(ctx))
+	state, ok := ctx.Value(graphQLStateKey{}).(*graphQLState)
+	if !ok {
+		return LoadFavorites(owner)
+	}
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.favorites == nil {
+		favorites, err := LoadFavorites(owner)
+		if err != nil {
+			return nil, err
+		}
+		state.favorites = favorites
+	}
+	return state.favorites, nil
+}
+
+// graphQLSeason
+// Retourne la saison demandée en argument (saison par défaut si absente).
+func graphQLSeason(p graphql.ResolveParams) string {
+	season, _ := p.Args["season"].(string)
+	return ResolveSeason(season)
+}
+
+// graphQLIntArg
+// Retourne un argument entier sous forme de chaîne (vide s'il est absent), comme un paramètre de query.
+func graphQLIntArg(p graphql.ResolveParams, name string) string {
+	if value, ok := p.Args[name].(int); ok {
+		return strconv.Itoa(value)
+	}
+	return ""
+}
+
+// graphQLPageRequest
+// Lit les arguments page, perPage et cursor d'une requête paginée.
+func graphQLPageRequest(p graphql.ResolveParams) models.PageRequest {
+	cursor, _ := p.Args["cursor"].(string)
+	return models.PageRequest{Page: graphQLIntArg(p, "page"), PerPage: graphQLIntArg(p, "perPage"), Cursor: cursor}
+}
+
+// graphQLStrings
+// Convertit une liste de chaînes reçue en argument (vide si absente).
+func graphQLStrings(value interface{}) []string {
+	values := []string{}
+	list, _ := value.([]interface{})
+	for _, item := range list {
+		if text, ok := item.(string); ok {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+// driverField
+// Déclare un champ scalaire d'un pilote.
+func driverField(fieldType graphql.Output, get func(models.Driver) string) *graphql.Field {
+	return &graphql.Field{
+		Type: fieldType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(graphQLDriver).driver), nil
+		},
+	}
+}
+
+// constructorField
+// Déclare un champ scalaire d'une écurie.
+func constructorField(fieldType graphql.Output, get func(models.Constructor) string) *graphql.Field {
+	return &graphql.Field{
+		Type: fieldType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(graphQLConstructor).constructor), nil
+		},
+	}
+}
+
+// paginationField
+// Déclare un champ entier de la pagination.
+func paginationField(get func(models.Pagination) int) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Int),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(models.Pagination)), nil
+		},
+	}
+}
+
+// paginationCursorField
+// Déclare un champ curseur de la pagination (null s'il n'y a pas de page dans ce sens).
+func paginationCursorField(description string, get func(models.Pagination) string) *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.String,
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if cursor := get(p.Source.(models.Pagination)); cursor != "" {
+				return cursor, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+// wrapGraphQLDrivers
+// Associe la saison à chaque pilote d'une liste.
+func wrapGraphQLDrivers(drivers []models.Driver, season string) []graphQLDriver {
+	wrapped := make([]graphQLDriver, 0, len(drivers))
+	for _, driver := range drivers {
+		wrapped = append(wrapped, graphQLDriver{driver: driver, season: season})
+	}
+	return wrapped
+}
+
+// wrapGraphQLConstructors
+// Associe la saison à chaque écurie d'une liste.
+func wrapGraphQLConstructors(constructors []models.Constructor, season string) []graphQLConstructor {
+	wrapped := make([]graphQLConstructor, 0, len(constructors))
+	for _, constructor := range constructors {
+		wrapped = append(wrapped, graphQLConstructor{constructor: constructor, season: season})
+	}
+	return wrapped
+}
+
+// containsID
+// Indique si un identifiant figure dans une liste.
+func containsID(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
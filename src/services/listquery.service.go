@@ -0,0 +1,211 @@
+package services
+
+import (
+	"f1-app/models"
+	"slices"
+	"sort"
+)
+
+// listFacet
+// Facette d'une liste (pilotes, écuries) : paramètre d'URL, libellé, valeur d'un élément et libellé affiché d'une valeur
+// (nil : la valeur elle-même).
+type listFacet[T any] struct {
+	name       string
+	label      string
+	value      func(T) string
+	valueLabel func(string) string
+}
+
+// listQuery
+// Filtres d'une liste : facettes, valeurs sélectionnées par facette (plusieurs valeurs : l'une ou l'autre) et prédicats
+// supplémentaires, toujours appliqués (tranche d'âge...).
+type listQuery[T any] struct {
+	facets     []listFacet[T]
+	selected   map[string][]string
+	predicates []func(T) bool
+}
+
+// listSortKey
+// Clé de tri d'une liste : missing indique un élément sans valeur pour la clé (toujours placé en fin de liste),
+// compare compare deux éléments (nil : ordre de la liste).
+type listSortKey[T any] struct {
+	missing func(T) bool
+	compare func(a, b T) int
+}
+
+// filter
+// Retourne les éléments correspondant à tous les filtres, dans l'ordre d'origine.
+func (q listQuery[T]) filter(items []T) []T {
+	filtered := []T{}
+	for _, item := range items {
+		if q.matches(item, "") {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// matches
+// Indique si un élément correspond aux prédicats et aux filtres de toutes les facettes, sauf celle nommée except.
+func (q listQuery[T]) matches(item T, except string) bool {
+	for _, predicate := range q.predicates {
+		if !predicate(item) {
+			return false
+		}
+	}
+	for _, facet := range q.facets {
+		selected := q.selected[facet.name]
+		if facet.name == except || len(selected) == 0 {
+			continue
+		}
+		if !slices.Contains(selected, facet.value(item)) {
+			return false
+		}
+	}
+	return true
+}
+
+// facetsFor
+// ---------
+// Objectif :
+//   - Construire les facettes de la liste à partir de tous ses éléments.
+//   - Compter, pour chaque valeur, les éléments obtenus en la sélectionnant : le compte tient compte des autres facettes
+//     actives et des prédicats, mais pas de la facette elle-même (les valeurs d'une facette s'additionnent).
+//   - Trier les valeurs par ordre alphabétique (sans tenir compte de la casse ni des accents), pour un affichage stable.
+//   - Conserver une valeur sélectionnée absente de la liste (compte nul) afin de pouvoir la désélectionner.
+func (q listQuery[T]) facetsFor(items []T) []models.Facet {
+	facets := []models.Facet{}
+	for _, facet := range q.facets {
+
+		// Étape 1 : Compter les éléments par valeur, parmi ceux retenus par les autres filtres.
+		counts := map[string]int{}
+		for _, item := range items {
+			value := facet.value(item)
+			if value == "" {
+				continue
+			}
+			if _, ok := counts[value]; !ok {
+				counts[value] = 0
+			}
+			if q.matches(item, facet.name) {
+				counts[value]++
+			}
+		}
+
+		// Étape 2 : Ajouter les valeurs sélectionnées et marquer la sélection.
+		selected := q.selected[facet.name]
+		for _, value := range selected {
+			if _, ok := counts[value]; !ok {
+				counts[value] = 0
+			}
+		}
+		values := []models.FacetValue{}
+		for value, count := range counts {
+			facetValue := models.FacetValue{Value: value, Count: count, Selected: slices.Contains(selected, value)}
+			if facet.valueLabel != nil {
+				facetValue.Label = facet.valueLabel(value)
+			}
+			values = append(values, facetValue)
+		}
+
+		// Étape 3 : Trier les valeurs (ordre alphabétique replié, puis valeur exacte pour départager).
+		sort.Slice(values, func(i, j int) bool {
+			left, right := foldSearchText(values[i].Value), foldSearchText(values[j].Value)
+			if left != right {
+				return left < right
+			}
+			return values[i].Value < values[j].Value
+		})
+		facets = append(facets, models.Facet{Name: facet.name, Label: facet.label, Values: values})
+	}
+	return facets
+}
+
+// sortItems
+// ---------
+// Objectif :
+//   - Trier une liste selon une clé, dans le sens demandé (desc inverse l'ordre).
+//   - Placer toujours en fin de liste les éléments sans valeur pour la clé.
+//   - Conserver l'ordre de la liste entre éléments à égalité (tri stable) ; sans fonction de comparaison,
+//     l'ordre de la liste est conservé ou inversé.
+func sortItems[T any](items []T, key listSortKey[T], order string) {
+
+	// Étape 1 : L'ordre de la liste est conservé, ou inversé.
+	if key.compare == nil {
+		if order == OrderDesc {
+			slices.Reverse(items)
+		}
+		return
+	}
+
+	// Étape 2 : Trier (les valeurs manquantes restent en fin de liste quel que soit le sens).
+	sort.SliceStable(items, func(i, j int) bool {
+		left, right := items[i], items[j]
+		leftMissing := key.missing != nil && key.missing(left)
+		rightMissing := key.missing != nil && key.missing(right)
+		if leftMissing || rightMissing {
+			return !leftMissing
+		}
+		result := key.compare(left, right)
+		if order == OrderDesc {
+			result = -result
+		}
+		return result < 0
+	})
+}
+
+// paginateItems
+// -------------
+// Objectif :
+//   - Découper la page demandée d'une liste triée : par numéro de page, ou à partir d'un curseur opaque
+//     (le curseur désigne l'élément qui précède ou suit la page : la page reste stable si des éléments sont ajoutés ailleurs).
+//   - Refuser une taille de page non autorisée (ErrInvalidPageSize) et un curseur illisible, produit pour une autre requête
+//     (query : empreinte de la saison, du tri et des filtres) ou dont l'élément a disparu (ErrInvalidCursor).
+//   - Renseigner les curseurs des pages suivante et précédente (vides en fin et en début de liste).
+func paginateItems[T any](items []T, id func(T) string, query string, request models.PageRequest) ([]T, models.Pagination, error) {
+
+	// Étape 1 : Valider la taille de page.
+	perPage, err := ParsePageSize(request.PerPage)
+	if err != nil {
+		return nil, models.Pagination{}, err
+	}
+
+	// Étape 2 : Sans curseur, découper la page numérotée.
+	var pagination models.Pagination
+	var start, end int
+	if request.Cursor == "" {
+		pagination = pageAt(len(items), parsePageNumber(request.Page), perPage)
+		start, end = pageBounds(pagination)
+	} else {
+
+		// Étape 3 : Avec un curseur, partir de l'élément de référence (page suivante ou précédente).
+		cursor, err := decodeCursor(request.Cursor, query)
+		if err != nil {
+			return nil, models.Pagination{}, err
+		}
+		anchor := slices.IndexFunc(items, func(item T) bool { return id(item) == cursor.ID })
+		if anchor < 0 {
+			return nil, models.Pagination{}, ErrInvalidCursor
+		}
+		if cursor.Before {
+			start, end = max(anchor-perPage, 0), anchor
+		} else {
+			start, end = anchor+1, min(anchor+1+perPage, len(items))
+		}
+		pagination = withPageIndexes(models.Pagination{
+			Page:       start/perPage + 1,
+			PerPage:    perPage,
+			TotalPages: (len(items) + perPage - 1) / perPage,
+			Total:      len(items),
+		}, start, end)
+	}
+
+	// Étape 4 : Renseigner les curseurs des pages voisines.
+	if end > start && end < len(items) {
+		pagination.NextCursor = encodeCursor(listCursor{Query: query, ID: id(items[end-1])})
+	}
+	if end > start && start > 0 {
+		pagination.PrevCursor = encodeCursor(listCursor{Query: query, ID: id(items[start]), Before: true})
+	}
+	return items[start:end], pagination, nil
+}
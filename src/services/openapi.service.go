@@ -0,0 +1,400 @@
+package services
+
+import (
+	"f1-app/helpers"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Version de la spécification OpenAPI et version documentée de l'API.
+const (
+	openAPIVersion = "3.0.3"
+	apiVersion     = "1.0.0"
+)
+
+// routeDocs contient les opérations des routes enregistrées (fournies par le routeur principal).
+var routeDocs []models.RouteDoc
+
+// documentedSchemas liste les schémas toujours publiés, même si aucune réponse ne les référence.
+var documentedSchemas = []interface{}{
+	models.Driver{},
+	models.Constructor{},
+	models.Favorites{},
+	models.APIErrorResponse{},
+}
+
+// schemaDescriptions décrit les schémas principaux exposés aux équipes clientes.
+var schemaDescriptions = map[string]string{
+	"Driver":             "Formula 1 driver of a season, enriched with team, portrait and driver type.",
+	"Constructor":        "Formula 1 constructor (team) of a season, enriched with logo, car image and colour.",
+	"Favorites":          "Favorites of the visitor: ordered collections, and the driver and constructor identifiers they contain.",
+	"FavoriteCollection": "Named collection of favorites, in the order chosen by the visitor (the first one is the default collection).",
+	"FavoriteItem":       "Driver or constructor saved in a collection, with a free-text note, tags and the date it was added.",
+	"FavoritesExport":    "JSON export of the favorites (format version, export date and collections), accepted by /favorites/import.",
+	"APIErrorResponse":   "Error envelope returned by every JSON endpoint.",
+	"APIError":           "HTTP status code and human readable message of an error.",
+	"MRData":             "Ergast-compatible response envelope.",
+}
+
+// tagDescriptions décrit les groupes d'opérations de la spécification.
+var tagDescriptions = map[string]string{
+	"pages":     "Server-rendered HTML pages.",
+	"favorites": "Favorite drivers and constructors of the visitor (HTML actions).",
+	"accounts":  "User accounts and cookie-based sessions.",
+	"api":       "Versioned JSON API.",
+	"ergast":    "Ergast-compatible mirror (JSON and XML).",
+	"graphql":   "GraphQL endpoint over drivers, constructors and favorites.",
+	"docs":      "API documentation.",
+}
+
+// UseRouteDocs
+// Remplace la liste des opérations documentées (appelée par le routeur principal au démarrage).
+func UseRouteDocs(docs []models.RouteDoc) {
+	routeDocs = docs
+}
+
+// GetOpenAPIDocument
+// ------------------
+// Objectif :
+//   - Construire la spécification OpenAPI 3 à partir des routes enregistrées par les routeurs.
+//   - Déduire les schémas (Driver, Constructor, Favorites, erreurs, réponses) par réflexion sur les tags json.
+//   - Retourner un objet ordonné encodable en JSON (encoding/json) ou en YAML (helpers.MarshalYAML).
+func GetOpenAPIDocument() *models.OrderedMap {
+	schemas := models.NewOrderedMap()
+
+	// Étape 1 : Publier les schémas principaux en tête des composants.
+	for _, value := range documentedSchemas {
+		schemaFor(reflect.TypeOf(value), schemas)
+	}
+
+	// Étape 2 : Regrouper les opérations par chemin, dans l'ordre d'enregistrement.
+	paths := models.NewOrderedMap()
+	tags := []interface{}{}
+	seenTags := map[string]bool{}
+	for _, doc := range routeDocs {
+		item, ok := paths.Get(doc.Path)
+		if !ok {
+			item = models.NewOrderedMap()
+			paths.Set(doc.Path, item)
+		}
+		item.(*models.OrderedMap).Set(strings.ToLower(doc.Method), buildOperation(doc, schemas))
+
+		if doc.Tag != "" && !seenTags[doc.Tag] {
+			seenTags[doc.Tag] = true
+			tags = append(tags, models.NewOrderedMap().
+				Set("name", doc.Tag).
+				Set("description", tagDescriptions[doc.Tag]))
+		}
+	}
+
+	// Étape 3 : Assembler le document.
+	info := models.NewOrderedMap().
+		Set("title", "F1 App API").
+		Set("version", apiVersion).
+		Set("description", "Formula 1 drivers, constructors, races and favorites: HTML pages, versioned JSON API and Ergast-compatible mirror. Seasons available: "+strings.Join(GetSeasons(), ", ")+".")
+
+	return models.NewOrderedMap().
+		Set("openapi", openAPIVersion).
+		Set("info", info).
+		Set("servers", []interface{}{models.NewOrderedMap().Set("url", "/")}).
+		Set("tags", tags).
+		Set("paths", paths).
+		Set("components", models.NewOrderedMap().Set("schemas", schemas))
+}
+
+// buildOperation
+// --------------
+// Objectif :
+//   - Traduire une RouteDoc en objet Operation OpenAPI (paramètres, corps de formulaire, réponses).
+//   - Les erreurs des routes JSON renvoient APIErrorResponse ; celles des pages HTML redirigent vers /error.
+func buildOperation(doc models.RouteDoc, schemas *models.OrderedMap) *models.OrderedMap {
+	operation := models.NewOrderedMap().
+		Set("operationId", doc.OperationID).
+		Set("summary", doc.Summary)
+	if doc.Description != "" {
+		operation.Set("description", doc.Description)
+	}
+	if doc.Tag != "" {
+		operation.Set("tags", []string{doc.Tag})
+	}
+
+	// Étape 1 : Paramètres de chemin et de query (et jeton CSRF des opérations qui modifient des données).
+	docParams := doc.Params
+	errorCodes := doc.Errors
+	if doc.Method != http.MethodGet {
+		docParams = append(append([]models.ParamDoc{}, docParams...), csrfHeaderParam)
+		if !containsStatus(errorCodes, http.StatusForbidden) {
+			errorCodes = append(append([]int{}, errorCodes...), http.StatusForbidden)
+		}
+	}
+	if len(docParams) > 0 {
+		params := []interface{}{}
+		for _, param := range docParams {
+			params = append(params, buildParameter(param))
+		}
+		operation.Set("parameters", params)
+	}
+
+	// Étape 2 : Corps de la requête (formulaire des actions POST ou JSON).
+	if len(doc.Form) > 0 {
+		properties := models.NewOrderedMap()
+		required := []string{}
+		for _, field := range doc.Form {
+			properties.Set(field.Name, paramSchema(field))
+			if field.Required {
+				required = append(required, field.Name)
+			}
+		}
+		schema := models.NewOrderedMap().Set("type", "object").Set("properties", properties)
+		if len(required) > 0 {
+			schema.Set("required", required)
+		}
+		encoding := "application/x-www-form-urlencoded"
+		if doc.Multipart {
+			encoding = "multipart/form-data"
+		}
+		operation.Set("requestBody", models.NewOrderedMap().
+			Set("required", true).
+			Set("content", models.NewOrderedMap().
+				Set(encoding, models.NewOrderedMap().Set("schema", schema))))
+	}
+
+	if doc.RequestBody != nil {
+		operation.Set("requestBody", models.NewOrderedMap().
+			Set("required", true).
+			Set("content", models.NewOrderedMap().
+				Set("application/json", models.NewOrderedMap().Set("schema", schemaFor(reflect.TypeOf(doc.RequestBody), schemas)))))
+	}
+
+	// Étape 3 : Réponse de succès (page, données ou redirection).
+	responses := models.NewOrderedMap()
+	isHTML := doc.Redirect
+	redirectDescription := "Redirects to returnUrl (or / when empty)."
+	if doc.RedirectTo != "" {
+		redirectDescription = "Redirects to " + doc.RedirectTo + "."
+	}
+	if doc.Redirect {
+		responses.Set("303", models.NewOrderedMap().
+			Set("description", redirectDescription))
+	} else {
+		content := models.NewOrderedMap()
+		for _, contentType := range doc.ContentTypes {
+			var schema interface{}
+			switch contentType {
+			case "text/html":
+				isHTML = true
+				schema = models.NewOrderedMap().Set("type", "string")
+			case "text/csv":
+				// Un téléchargement est ouvert par le navigateur : ses erreurs redirigent vers la page d'erreur.
+				isHTML = true
+				schema = models.NewOrderedMap().Set("type", "string")
+			default:
+				schema = schemaFor(reflect.TypeOf(doc.Response), schemas)
+			}
+			content.Set(contentType, models.NewOrderedMap().Set("schema", schema))
+		}
+		response := models.NewOrderedMap().Set("description", "OK")
+		if len(doc.Headers) > 0 {
+			headers := models.NewOrderedMap()
+			for _, header := range doc.Headers {
+				headers.Set(header.Name, models.NewOrderedMap().
+					Set("description", header.Description).
+					Set("schema", paramSchema(header)))
+			}
+			response.Set("headers", headers)
+		}
+		responses.Set("200", response.Set("content", content))
+	}
+
+	// Étape 4 : Réponses d'erreur.
+	if len(errorCodes) > 0 {
+		if isHTML {
+			codes := []string{}
+			for _, code := range errorCodes {
+				codes = append(codes, strconv.Itoa(code))
+			}
+			description := fmt.Sprintf("Error (%s): redirects to /error?code={code}&message={message}.", strings.Join(codes, ", "))
+			if doc.Redirect {
+				responses.Set("303", models.NewOrderedMap().
+					Set("description", redirectDescription+" "+description))
+			} else {
+				responses.Set("303", models.NewOrderedMap().Set("description", description))
+			}
+		} else {
+			errorSchema := schemaFor(reflect.TypeOf(models.APIErrorResponse{}), schemas)
+			for _, code := range errorCodes {
+				responses.Set(strconv.Itoa(code), models.NewOrderedMap().
+					Set("description", http.StatusText(code)).
+					Set("content", models.NewOrderedMap().
+						Set("application/json", models.NewOrderedMap().Set("schema", errorSchema))))
+			}
+		}
+	}
+	operation.Set("responses", responses)
+	return operation
+}
+
+// csrfHeaderParam documente le jeton CSRF exigé par les opérations POST (voir CSRFMiddleware).
+var csrfHeaderParam = models.ParamDoc{
+	Name: helpers.CSRFHeaderName, In: "header", Type: "string",
+	Description: "CSRF token, required on every POST, PUT, PATCH or DELETE with or without a site cookie: the value of the f1_csrf cookie, also accepted as the csrf_token form field. On /graphql it is only required for mutations. Missing or wrong tokens get 403.",
+}
+
+// containsStatus
+// Indique si un code HTTP figure dans la liste.
+func containsStatus(codes []int, status int) bool {
+	for _, code := range codes {
+		if code == status {
+			return true
+		}
+	}
+	return false
+}
+
+// buildParameter
+// Traduit un ParamDoc en objet Parameter OpenAPI (un paramètre de chemin est toujours requis).
+func buildParameter(param models.ParamDoc) *models.OrderedMap {
+	parameter := models.NewOrderedMap().
+		Set("name", param.Name).
+		Set("in", param.In)
+	if param.Description != "" {
+		parameter.Set("description", param.Description)
+	}
+	parameter.Set("required", param.Required || param.In == "path")
+	parameter.Set("schema", paramSchema(param))
+	return parameter
+}
+
+// paramSchema
+// Construit le schéma d'un paramètre (type, format, valeurs autorisées, valeur par défaut ; tableau si répétable).
+func paramSchema(param models.ParamDoc) *models.OrderedMap {
+	paramType := param.Type
+	if paramType == "" {
+		paramType = "string"
+	}
+	schema := models.NewOrderedMap().Set("type", paramType)
+	if param.Format != "" {
+		schema.Set("format", param.Format)
+	}
+	if len(param.Enum) > 0 {
+		values := []interface{}{}
+		for _, value := range param.Enum {
+			values = append(values, paramValue(paramType, value))
+		}
+		schema.Set("enum", values)
+	}
+	if param.Default != "" {
+		schema.Set("default", paramValue(paramType, param.Default))
+	}
+	if param.Repeated {
+		return models.NewOrderedMap().Set("type", "array").Set("items", schema)
+	}
+	return schema
+}
+
+// paramValue
+// Convertit une valeur documentée en nombre pour un paramètre de type integer.
+func paramValue(paramType, value string) interface{} {
+	if paramType == "integer" {
+		if number, err := strconv.Atoi(value); err == nil {
+			return number
+		}
+	}
+	return value
+}
+
+// schemaFor
+// ---------
+// Objectif :
+//   - Retourner le schéma OpenAPI d'un type Go d'après ses tags json.
+//   - Enregistrer chaque structure une seule fois dans components/schemas et y faire référence ($ref).
+//   - Marquer comme requis les champs sans omitempty ; time.Time est décrit comme une chaîne date-time.
+func schemaFor(t reflect.Type, schemas *models.OrderedMap) interface{} {
+	if t == nil {
+		return models.NewOrderedMap()
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return models.NewOrderedMap().Set("type", "string").Set("format", "date-time")
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaFor(t.Elem(), schemas)
+	case reflect.String:
+		return models.NewOrderedMap().Set("type", "string")
+	case reflect.Bool:
+		return models.NewOrderedMap().Set("type", "boolean")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return models.NewOrderedMap().Set("type", "integer")
+	case reflect.Float32, reflect.Float64:
+		return models.NewOrderedMap().Set("type", "number")
+	case reflect.Slice, reflect.Array:
+		return models.NewOrderedMap().Set("type", "array").Set("items", schemaFor(t.Elem(), schemas))
+	case reflect.Map:
+		return models.NewOrderedMap().Set("type", "object").Set("additionalProperties", schemaFor(t.Elem(), schemas))
+	case reflect.Struct:
+		ref := models.NewOrderedMap().Set("$ref", "#/components/schemas/"+t.Name())
+		if _, exists := schemas.Get(t.Name()); exists {
+			return ref
+		}
+
+		// Étape 1 : Réserver le nom avant de parcourir les champs (types récursifs).
+		schema := models.NewOrderedMap().Set("type", "object")
+		schemas.Set(t.Name(), schema)
+		if description, ok := schemaDescriptions[t.Name()]; ok {
+			schema.Set("description", description)
+		}
+
+		// Étape 2 : Décrire les champs exportés selon leur tag json.
+		properties := models.NewOrderedMap()
+		required := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, omitEmpty, ok := jsonFieldName(field)
+			if !ok {
+				continue
+			}
+			properties.Set(name, schemaFor(field.Type, schemas))
+			if !omitEmpty && field.Type.Kind() != reflect.Ptr {
+				required = append(required, name)
+			}
+		}
+		schema.Set("properties", properties)
+		if len(required) > 0 {
+			schema.Set("required", required)
+		}
+		return ref
+	}
+	return models.NewOrderedMap()
+}
+
+// jsonFieldName
+// Retourne le nom JSON d'un champ et la présence d'omitempty (faux si le champ n'est pas sérialisé).
+func jsonFieldName(field reflect.StructField) (string, bool, bool) {
+	if field.PkgPath != "" {
+		return "", false, false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	omitEmpty := false
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, true
+}
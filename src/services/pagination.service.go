@@ -0,0 +1,181 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Erreurs de pagination : taille de page non autorisée, configuration invalide, curseur illisible ou périmé.
+var (
+	ErrInvalidPageSize  = errors.New("taille de page invalide")
+	ErrInvalidPageSizes = errors.New("tailles de page invalides (format : 10,20,50 ; entiers de 1 à 500)")
+	ErrInvalidCursor    = errors.New("curseur de pagination invalide ou expiré")
+)
+
+// maxPageSize borne les tailles de page configurables (F1_PAGE_SIZES).
+const maxPageSize = 500
+
+// pageSizes liste les tailles de page acceptées ; la première est la taille par défaut.
+var pageSizes = []int{10, 20, 30}
+
+// listCursor
+// Contenu d'un curseur de pagination : empreinte de la requête (saison, tri, sens, filtres), identifiant de l'élément
+// de référence et sens de lecture (Before : page précédant l'élément, sinon page qui le suit).
+type listCursor struct {
+	Query  string `json:"q"`
+	ID     string `json:"id"`
+	Before bool   `json:"b,omitempty"`
+}
+
+// PageSizes
+// Retourne les tailles de page acceptées (la première est la taille par défaut).
+func PageSizes() []int {
+	return slices.Clone(pageSizes)
+}
+
+// UsePageSizes
+// Remplace les tailles de page acceptées (appelé au démarrage, voir ParsePageSizes).
+func UsePageSizes(sizes []int) {
+	pageSizes = slices.Clone(sizes)
+}
+
+// ParsePageSizes
+// Lit une liste de tailles de page "10,25,50" (variable F1_PAGE_SIZES) : la première est la taille par défaut.
+func ParsePageSizes(input string) ([]int, error) {
+	sizes := []int{}
+	for _, value := range strings.Split(input, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 || size > maxPageSize || slices.Contains(sizes, size) {
+			return nil, fmt.Errorf("%w : %q", ErrInvalidPageSizes, value)
+		}
+		sizes = append(sizes, size)
+	}
+	if len(sizes) == 0 {
+		return nil, ErrInvalidPageSizes
+	}
+	return sizes, nil
+}
+
+// ParsePageSize
+// Lit le paramètre perPage : taille par défaut s'il est absent, ErrInvalidPageSize s'il ne fait pas partie des tailles acceptées.
+func ParsePageSize(perPageParam string) (int, error) {
+	if perPageParam == "" {
+		return pageSizes[0], nil
+	}
+	perPage, err := strconv.Atoi(perPageParam)
+	if err != nil || !slices.Contains(pageSizes, perPage) {
+		accepted := []string{}
+		for _, size := range pageSizes {
+			accepted = append(accepted, strconv.Itoa(size))
+		}
+		return 0, fmt.Errorf("%w : %q (valeurs acceptées : %s)", ErrInvalidPageSize, perPageParam, strings.Join(accepted, ", "))
+	}
+	return perPage, nil
+}
+
+// Paginate
+// --------
+// Objectif :
+//   - Lire les paramètres page et perPage (page 1 et taille par défaut si absents ou invalides).
+//   - Ramener la page demandée dans les bornes (dernière page si elle dépasse).
+//   - Calculer les indices affichés (« Showing X-Y of Z »), 0-0 pour une liste vide.
+func Paginate(total int, pageParam, perPageParam string) models.Pagination {
+
+	// Étape 1 : Traiter les paramètres de pagination.
+	perPage, err := ParsePageSize(perPageParam)
+	if err != nil {
+		perPage = pageSizes[0]
+	}
+
+	// Étape 2 : Calculer la page courante et ses indices.
+	return pageAt(total, parsePageNumber(pageParam), perPage)
+}
+
+// parsePageNumber
+// Lit le paramètre page (1 s'il est absent ou invalide).
+func parsePageNumber(pageParam string) int {
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+// pageAt
+// Construit la pagination d'une page numérotée (ramenée à la dernière page si elle dépasse).
+func pageAt(total, page, perPage int) models.Pagination {
+	totalPages := (total + perPage - 1) / perPage
+	if page > totalPages && totalPages > 0 {
+		page = totalPages
+	}
+	pagination := models.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+		Total:      total,
+	}
+	start, end := pageBounds(pagination)
+	return withPageIndexes(pagination, start, end)
+}
+
+// withPageIndexes
+// Renseigne les indices affichés d'une page [start, end), 0-0 pour une liste vide.
+func withPageIndexes(pagination models.Pagination, start, end int) models.Pagination {
+	if pagination.Total > 0 {
+		pagination.StartIndex = start + 1
+		pagination.EndIndex = end
+	}
+	return pagination
+}
+
+// pageBounds
+// Retourne les bornes [start, end) de la page courante dans la liste complète.
+func pageBounds(pagination models.Pagination) (int, int) {
+	start := (pagination.Page - 1) * pagination.PerPage
+	end := start + pagination.PerPage
+
+	if start > pagination.Total {
+		start = pagination.Total
+	}
+	if end > pagination.Total {
+		end = pagination.Total
+	}
+	return start, end
+}
+
+// queryFingerprint
+// Empreinte courte d'une requête (saison, tri, sens, filtres...) : un curseur n'est accepté que pour la requête qui l'a produit.
+func queryFingerprint(parts ...interface{}) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%#v", parts)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// encodeCursor
+// Encode un curseur en une chaîne opaque utilisable dans une URL.
+func encodeCursor(cursor listCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor
+// Décode un curseur (ErrInvalidCursor s'il est illisible ou s'il a été produit pour une autre requête).
+func decodeCursor(value, query string) (listCursor, error) {
+	var cursor listCursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || json.Unmarshal(data, &cursor) != nil || cursor.ID == "" || cursor.Query != query {
+		return listCursor{}, ErrInvalidCursor
+	}
+	return cursor, nil
+}
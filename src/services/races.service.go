@@ -0,0 +1,147 @@
+package services
+
+import (
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrRaceNotFound est retournée lorsque la manche demandée n'existe pas dans le calendrier de la saison.
+var ErrRaceNotFound = errors.New("course introuvable")
+
+// RaceSource
+// Interface d'accès au calendrier d'une saison (forme de /{season}/races dans l'API Ergast).
+type RaceSource interface {
+	Races(season string) ([]models.Race, error)
+}
+
+// raceSource est la source du calendrier utilisée par les services (données intégrées par défaut).
+var raceSource RaceSource = EmbeddedSource{}
+
+// UseRaceSource
+// Remplace la source du calendrier utilisée par les services (ignorée si nil).
+func UseRaceSource(source RaceSource) {
+	if source != nil {
+		raceSource = source
+	}
+}
+
+// Races
+// Retourne le calendrier intégré de la saison, ou ErrSeasonNotFound.
+func (EmbeddedSource) Races(season string) ([]models.Race, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Races, nil
+}
+
+// Races
+// Récupère toutes les pages de /f1/{season}/races depuis l'API, ou ErrSeasonNotFound si le calendrier est vide.
+func (s *ErgastSource) Races(season string) ([]models.Race, error) {
+	var races []models.Race
+	err := s.fetchAll(season, "races", func(data models.MRData) int {
+		if data.RaceTable == nil {
+			return 0
+		}
+		races = append(races, data.RaceTable.Races...)
+		return len(data.RaceTable.Races)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(races) == 0 {
+		return nil, ErrSeasonNotFound
+	}
+	return races, nil
+}
+
+// GetRacesService
+// ---------------
+// Objectif :
+//   - Récupérer le calendrier complet d'une saison.
+//   - Identifier la prochaine course à venir (nil si la saison est terminée).
+//   - Retourner les données formatées pour le template.
+func GetRacesService(season string) (*models.PageData, int, error) {
+
+	// Étape 1 : Récupérer le calendrier depuis la source de données.
+	races, err := raceSource.Races(season)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
+	}
+
+	// Étape 2 : Déterminer la prochaine course.
+	nextRace := GetNextRace(races, time.Now())
+	nextRound := ""
+	if nextRace != nil {
+		nextRound = nextRace.Round
+	}
+
+	// Étape 3 : Préparer les données pour le template.
+	pageData := &models.PageData{
+		Title:       fmt.Sprintf("Calendrier %s", season),
+		CurrentPage: "races",
+		Data: map[string]interface{}{
+			"season":    season,
+			"seasons":   GetSeasons(),
+			"races":     races,
+			"nextRace":  nextRace,
+			"nextRound": nextRound,
+		},
+	}
+
+	// Étape 4 : Retourner les données avec le statut HTTP OK.
+	return pageData, http.StatusOK, nil
+}
+
+// GetRaceService
+// --------------
+// Objectif :
+//   - Récupérer une course précise du calendrier d'une saison à partir de son numéro de manche.
+//   - Retourner 404 si la saison ou la manche n'existe pas.
+func GetRaceService(season, round string) (*models.Race, int, error) {
+
+	// Étape 1 : Récupérer le calendrier depuis la source de données.
+	races, err := raceSource.Races(season)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
+	}
+
+	// Étape 2 : Rechercher la manche demandée.
+	for i := range races {
+		if races[i].Round == round {
+			return &races[i], http.StatusOK, nil
+		}
+	}
+
+	// Étape 3 : Retourner une 404 si la manche n'existe pas.
+	return nil, http.StatusNotFound, fmt.Errorf("%w : manche %s de la saison %s", ErrRaceNotFound, round, season)
+}
+
+// GetNextRace
+// Retourne la première course dont le départ n'est pas encore passé à l'instant now (nil si aucune).
+func GetNextRace(races []models.Race, now time.Time) *models.Race {
+	for i := range races {
+		start, ok := raceStart(races[i])
+		if ok && start.After(now) {
+			return &races[i]
+		}
+	}
+	return nil
+}
+
+// raceStart
+// Calcule l'heure de départ (UTC) d'une course à partir de sa date et de son heure.
+func raceStart(race models.Race) (time.Time, bool) {
+	clock := race.Time
+	if clock == "" {
+		clock = "00:00:00Z"
+	}
+	start, err := time.Parse(time.RFC3339, race.Date+"T"+clock)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
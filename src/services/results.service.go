@@ -0,0 +1,285 @@
+package services
+
+import (
+	"f1-app/models"
+	"net/http"
+	"strconv"
+)
+
+// ResultSource
+// Interface d'accès aux résultats d'une manche (tables Results, SprintResults et QualifyingResults d'Ergast).
+// Une manche sans résultats (pas encore courue) retourne une liste vide sans erreur.
+type ResultSource interface {
+	RaceResults(season, round string) ([]models.RaceResult, error)
+	SprintResults(season, round string) ([]models.RaceResult, error)
+	QualifyingResults(season, round string) ([]models.QualifyingResult, error)
+	SeasonResults(season string) (map[string]models.RoundResults, error)
+}
+
+// resultSource est la source des résultats utilisée par les services (données intégrées par défaut).
+var resultSource ResultSource = EmbeddedSource{}
+
+// UseResultSource
+// Remplace la source des résultats utilisée par les services (ignorée si nil) et oublie les index des saisons
+// (classements compris).
+func UseResultSource(source ResultSource) {
+	if source != nil {
+		resultSource = source
+		InvalidateSeasonIndexes()
+	}
+}
+
+// RaceResults
+// Retourne les résultats de course intégrés d'une manche, ou ErrSeasonNotFound.
+func (EmbeddedSource) RaceResults(season, round string) ([]models.RaceResult, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Results[round].Race, nil
+}
+
+// SprintResults
+// Retourne les résultats de sprint intégrés d'une manche, ou ErrSeasonNotFound.
+func (EmbeddedSource) SprintResults(season, round string) ([]models.RaceResult, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Results[round].Sprint, nil
+}
+
+// QualifyingResults
+// Retourne les résultats de qualifications intégrés d'une manche, ou ErrSeasonNotFound.
+func (EmbeddedSource) QualifyingResults(season, round string) ([]models.QualifyingResult, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Results[round].Qualifying, nil
+}
+
+// SeasonResults
+// Retourne les résultats de course et de sprint intégrés de toutes les manches de la saison, ou ErrSeasonNotFound.
+func (EmbeddedSource) SeasonResults(season string) (map[string]models.RoundResults, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Results, nil
+}
+
+// RaceResults
+// Récupère toutes les pages de /f1/{season}/{round}/results depuis l'API.
+func (s *ErgastSource) RaceResults(season, round string) ([]models.RaceResult, error) {
+	var results []models.RaceResult
+	err := s.fetchAll(season, round+"/results", func(data models.MRData) int {
+		count := 0
+		if data.RaceTable != nil {
+			for _, race := range data.RaceTable.Races {
+				results = append(results, race.Results...)
+				count += len(race.Results)
+			}
+		}
+		return count
+	})
+	return results, err
+}
+
+// SprintResults
+// Récupère toutes les pages de /f1/{season}/{round}/sprint depuis l'API.
+func (s *ErgastSource) SprintResults(season, round string) ([]models.RaceResult, error) {
+	var results []models.RaceResult
+	err := s.fetchAll(season, round+"/sprint", func(data models.MRData) int {
+		count := 0
+		if data.RaceTable != nil {
+			for _, race := range data.RaceTable.Races {
+				results = append(results, race.SprintResults...)
+				count += len(race.SprintResults)
+			}
+		}
+		return count
+	})
+	return results, err
+}
+
+// QualifyingResults
+// Récupère toutes les pages de /f1/{season}/{round}/qualifying depuis l'API.
+func (s *ErgastSource) QualifyingResults(season, round string) ([]models.QualifyingResult, error) {
+	var results []models.QualifyingResult
+	err := s.fetchAll(season, round+"/qualifying", func(data models.MRData) int {
+		count := 0
+		if data.RaceTable != nil {
+			for _, race := range data.RaceTable.Races {
+				results = append(results, race.QualifyingResults...)
+				count += len(race.QualifyingResults)
+			}
+		}
+		return count
+	})
+	return results, err
+}
+
+// SeasonResults
+// -------------
+// Objectif :
+//   - Récupérer toutes les pages de /f1/{season}/results et /f1/{season}/sprint depuis l'API.
+//   - Regrouper les lignes par manche (une même course peut être répartie sur deux pages).
+//   - Les qualifications ne sont pas chargées : elles ne comptent pas au championnat.
+func (s *ErgastSource) SeasonResults(season string) (map[string]models.RoundResults, error) {
+	rounds := map[string]models.RoundResults{}
+
+	// Étape 1 : Charger les résultats de course de toute la saison.
+	err := s.fetchAll(season, "results", func(data models.MRData) int {
+		count := 0
+		if data.RaceTable != nil {
+			for _, race := range data.RaceTable.Races {
+				round := rounds[race.Round]
+				round.Race = append(round.Race, race.Results...)
+				rounds[race.Round] = round
+				count += len(race.Results)
+			}
+		}
+		return count
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Étape 2 : Charger les résultats de sprint de toute la saison.
+	err = s.fetchAll(season, "sprint", func(data models.MRData) int {
+		count := 0
+		if data.RaceTable != nil {
+			for _, race := range data.RaceTable.Races {
+				round := rounds[race.Round]
+				round.Sprint = append(round.Sprint, race.SprintResults...)
+				rounds[race.Round] = round
+				count += len(race.SprintResults)
+			}
+		}
+		return count
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rounds, nil
+}
+
+// GetRaceResultsService
+// ---------------------
+// Objectif :
+//   - Récupérer les résultats de course, de sprint et de qualifications d'une manche.
+//   - Compléter pilotes et écuries avec les données de la saison (nom, image, couleur...).
+//   - Calculer le temps du vainqueur pour l'affichage des écarts.
+func GetRaceResultsService(season, round string) (*models.RoundResults, int, error) {
+
+	// Étape 1 : Récupérer les trois tables de résultats.
+	race, err := resultSource.RaceResults(season, round)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
+	}
+	sprint, err := resultSource.SprintResults(season, round)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
+	}
+	qualifying, err := resultSource.QualifyingResults(season, round)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
+	}
+
+	// Étape 2 : Indexer les pilotes et écuries de la saison (sans bloquer s'ils manquent).
+	driversByID, constructorsByID := indexSeasonRoster(season)
+
+	// Étape 3 : Compléter chaque ligne sur une copie (les données intégrées ne doivent pas être modifiées).
+	results := &models.RoundResults{
+		Race:       enrichRaceResults(race, driversByID, constructorsByID),
+		Sprint:     enrichRaceResults(sprint, driversByID, constructorsByID),
+		Qualifying: make([]models.QualifyingResult, len(qualifying)),
+	}
+	for i, result := range qualifying {
+		result.Driver = enrichDriver(result.Driver, driversByID)
+		result.Constructor = enrichConstructor(result.Constructor, constructorsByID)
+		results.Qualifying[i] = result
+	}
+
+	// Étape 4 : Retenir le temps total des vainqueurs pour le calcul des écarts.
+	results.RaceWinnerMillis = winnerMillis(results.Race)
+	results.SprintWinnerMillis = winnerMillis(results.Sprint)
+
+	return results, http.StatusOK, nil
+}
+
+// indexSeasonRoster
+// Retourne les index par identifiant des pilotes et écuries de la saison, partagés en lecture seule
+// (index vides si la source échoue).
+func indexSeasonRoster(season string) (map[string]models.Driver, map[string]models.Constructor) {
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return map[string]models.Driver{}, map[string]models.Constructor{}
+	}
+	return index.driversByID, index.constructorsByID
+}
+
+// enrichRaceResults
+// Retourne une copie des résultats dont les pilotes et écuries sont complétés depuis les index de la saison.
+func enrichRaceResults(results []models.RaceResult, driversByID map[string]models.Driver, constructorsByID map[string]models.Constructor) []models.RaceResult {
+	enriched := make([]models.RaceResult, len(results))
+	for i, result := range results {
+		result.Driver = enrichDriver(result.Driver, driversByID)
+		result.Constructor = enrichConstructor(result.Constructor, constructorsByID)
+		enriched[i] = result
+	}
+	return enriched
+}
+
+// enrichDriver
+// Complète un pilote de résultat avec la fiche de la saison (les champs déjà renseignés sont conservés).
+func enrichDriver(driver models.Driver, driversByID map[string]models.Driver) models.Driver {
+	known, ok := driversByID[driver.DriverID]
+	if !ok {
+		return driver
+	}
+	if driver.GivenName == "" {
+		driver.GivenName = known.GivenName
+		driver.FamilyName = known.FamilyName
+	}
+	if driver.Code == "" {
+		driver.Code = known.Code
+	}
+	if driver.Nationality == "" {
+		driver.Nationality = known.Nationality
+	}
+	driver.Image = known.Image
+	return driver
+}
+
+// enrichConstructor
+// Complète une écurie de résultat avec la fiche de la saison (nom, logo, couleur).
+func enrichConstructor(constructor models.Constructor, constructorsByID map[string]models.Constructor) models.Constructor {
+	known, ok := constructorsByID[constructor.ConstructorID]
+	if !ok {
+		return constructor
+	}
+	if constructor.Name == "" {
+		constructor.Name = known.Name
+	}
+	if constructor.Nationality == "" {
+		constructor.Nationality = known.Nationality
+	}
+	constructor.Icon = known.Icon
+	constructor.TeamColor = known.TeamColor
+	return constructor
+}
+
+// winnerMillis
+// Retourne le temps total (en millisecondes) du vainqueur, ou 0 s'il est inconnu.
+func winnerMillis(results []models.RaceResult) int {
+	if len(results) == 0 || results[0].Time == nil {
+		return 0
+	}
+	millis, err := strconv.Atoi(results[0].Time.Millis)
+	if err != nil {
+		return 0
+	}
+	return millis
+}
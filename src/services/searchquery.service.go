@@ -0,0 +1,797 @@
+package services
+
+import (
+	"f1-app/models"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Limites d'une requête de recherche (taille et imbrication des parenthèses).
+const (
+	searchQueryMaxLength = 500
+	searchQueryMaxDepth  = 20
+)
+
+// Opérateurs d'un filtre de champ (team:ferrari, number:<10, born:>=2000).
+const (
+	SearchOpEqual        = ":"
+	SearchOpLess         = "<"
+	SearchOpLessEqual    = "<="
+	SearchOpGreater      = ">"
+	SearchOpGreaterEqual = ">="
+)
+
+// SearchSyntaxError
+// Erreur de syntaxe d'une requête de recherche, avec la position (en caractères) de la partie fautive.
+type SearchSyntaxError struct {
+	Query    string
+	Position int
+	Length   int
+	Message  string
+}
+
+// Error
+// Retourne le message suivi de la position (à partir de 1) dans la requête.
+func (e *SearchSyntaxError) Error() string {
+	return fmt.Sprintf("%s (position %d)", e.Message, e.Position+1)
+}
+
+// Before
+// Retourne la partie de la requête située avant l'erreur (affichage dans le template).
+func (e *SearchSyntaxError) Before() string {
+	runes := []rune(e.Query)
+	return string(runes[:min(e.Position, len(runes))])
+}
+
+// Highlight
+// Retourne la partie fautive de la requête (vide si l'erreur est en fin de requête).
+func (e *SearchSyntaxError) Highlight() string {
+	runes := []rune(e.Query)
+	start := min(e.Position, len(runes))
+	return string(runes[start:min(start+e.Length, len(runes))])
+}
+
+// After
+// Retourne la partie de la requête située après l'erreur.
+func (e *SearchSyntaxError) After() string {
+	runes := []rune(e.Query)
+	return string(runes[min(e.Position+e.Length, len(runes)):])
+}
+
+// SearchNode
+// Nœud de l'arbre syntaxique d'une requête de recherche, évalué sur un pilote ou une écurie
+// (score de pertinence et portions de texte à surligner si l'élément correspond).
+type SearchNode interface {
+	matchDriver(driver models.Driver) (searchMatch, bool)
+	matchConstructor(constructor models.Constructor) (searchMatch, bool)
+	String() string
+}
+
+// SearchAnd
+// Nœud vrai si tous ses termes le sont (termes séparés par des espaces ou AND).
+type SearchAnd struct{ Nodes []SearchNode }
+
+// SearchOr
+// Nœud vrai si l'un de ses termes l'est (termes séparés par OR).
+type SearchOr struct{ Nodes []SearchNode }
+
+// SearchNot
+// Nœud vrai si son terme est faux (terme précédé de -).
+type SearchNot struct{ Node SearchNode }
+
+// SearchTerm
+// Texte libre (mot ou "phrase entre guillemets") cherché dans les noms, code, numéro, nationalité et écurie
+// (sans tenir compte des accents ; un mot tolère aussi quelques fautes de frappe).
+type SearchTerm struct {
+	Text   string
+	Phrase bool
+}
+
+// SearchField
+// Filtre sur un champ (team:ferrari, number:44, born:<2000).
+type SearchField struct {
+	Field string
+	Op    string
+	Value string
+}
+
+// searchMatch
+// Correspondance d'un élément avec un nœud : score de pertinence et portions à surligner par champ affiché.
+type searchMatch struct {
+	score int
+	spans map[string][]searchSpan
+}
+
+// add
+// Ajoute le score et les portions d'une autre correspondance.
+func (m *searchMatch) add(other searchMatch) {
+	m.score += other.score
+	for key, spans := range other.spans {
+		if m.spans == nil {
+			m.spans = map[string][]searchSpan{}
+		}
+		m.spans[key] = append(m.spans[key], spans...)
+	}
+}
+
+// searchTextField
+// Champ comparé au texte libre : clé d'affichage (surlignage), valeur, poids dans le score et tolérance aux fautes.
+type searchTextField struct {
+	key    string
+	value  string
+	weight int
+	fuzzy  bool
+}
+
+// searchFuzzyPenalty est le nombre de points retirés par faute de frappe tolérée.
+const searchFuzzyPenalty = 5
+
+// searchField
+// Description d'un champ filtrable : type de comparaison, poids dans le score, clés d'affichage (surlignage)
+// et valeur lue sur un pilote ou une écurie (nil : le champ ne concerne pas ce type d'élément, qui ne correspond jamais).
+type searchField struct {
+	numeric        bool
+	date           bool
+	exact          bool
+	weight         int
+	driverKey      string
+	constructorKey string
+	driver         func(models.Driver) string
+	constructor    func(models.Constructor) string
+}
+
+// searchFields
+// Champs reconnus par la syntaxe champ:valeur.
+var searchFields = map[string]searchField{
+	"team": {
+		weight: 50, driverKey: "team", constructorKey: "name",
+		driver:      func(d models.Driver) string { return d.Team },
+		constructor: func(c models.Constructor) string { return c.Name },
+	},
+	"nationality": {
+		weight: 30, driverKey: "nationality", constructorKey: "nationality",
+		driver:      func(d models.Driver) string { return d.Nationality },
+		constructor: func(c models.Constructor) string { return c.Nationality },
+	},
+	"type": {
+		weight: 30,
+		driver: func(d models.Driver) string { return d.DriverType },
+	},
+	"number": {
+		numeric: true, weight: 100, driverKey: "permanentNumber",
+		driver: func(d models.Driver) string { return d.PermanentNumber },
+	},
+	"born": {
+		date: true, weight: 30, driverKey: "dateOfBirth",
+		driver: func(d models.Driver) string { return d.DateOfBirth },
+	},
+	"name": {
+		weight: 80, driverKey: "fullName", constructorKey: "name",
+		driver:      func(d models.Driver) string { return d.GivenName + " " + d.FamilyName },
+		constructor: func(c models.Constructor) string { return c.Name },
+	},
+	"code": {
+		exact: true, weight: 100, driverKey: "code",
+		driver: func(d models.Driver) string { return d.Code },
+	},
+	"id": {
+		exact: true, weight: 100,
+		driver:      func(d models.Driver) string { return d.DriverID },
+		constructor: func(c models.Constructor) string { return c.ConstructorID },
+	},
+}
+
+// searchFieldAliases
+// Noms courts acceptés pour certains champs.
+var searchFieldAliases = map[string]string{
+	"nat":  "nationality",
+	"num":  "number",
+	"dob":  "born",
+	"kind": "type",
+}
+
+// searchFieldNamePattern reconnaît le nom d'un champ devant ":" (lettres uniquement).
+var searchFieldNamePattern = regexp.MustCompile(`^[A-Za-z]+$`)
+
+// SearchFieldNames
+// Retourne les noms des champs filtrables, triés (aide de la page de recherche et messages d'erreur).
+func SearchFieldNames() []string {
+	names := make([]string, 0, len(searchFields))
+	for name := range searchFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// matchDriver
+// Vrai si le pilote satisfait tous les termes (scores additionnés).
+func (n SearchAnd) matchDriver(driver models.Driver) (searchMatch, bool) {
+	return matchAll(n.Nodes, func(node SearchNode) (searchMatch, bool) { return node.matchDriver(driver) })
+}
+
+// matchConstructor
+// Vrai si l'écurie satisfait tous les termes (scores additionnés).
+func (n SearchAnd) matchConstructor(constructor models.Constructor) (searchMatch, bool) {
+	return matchAll(n.Nodes, func(node SearchNode) (searchMatch, bool) { return node.matchConstructor(constructor) })
+}
+
+// String
+// Représentation normalisée du nœud (termes entre parenthèses).
+func (n SearchAnd) String() string {
+	return "(" + joinSearchNodes(n.Nodes, " AND ") + ")"
+}
+
+// matchDriver
+// Vrai si le pilote satisfait l'un des termes (scores des termes satisfaits additionnés).
+func (n SearchOr) matchDriver(driver models.Driver) (searchMatch, bool) {
+	return matchAny(n.Nodes, func(node SearchNode) (searchMatch, bool) { return node.matchDriver(driver) })
+}
+
+// matchConstructor
+// Vrai si l'écurie satisfait l'un des termes (scores des termes satisfaits additionnés).
+func (n SearchOr) matchConstructor(constructor models.Constructor) (searchMatch, bool) {
+	return matchAny(n.Nodes, func(node SearchNode) (searchMatch, bool) { return node.matchConstructor(constructor) })
+}
+
+// String
+// Représentation normalisée du nœud (termes entre parenthèses).
+func (n SearchOr) String() string {
+	return "(" + joinSearchNodes(n.Nodes, " OR ") + ")"
+}
+
+// matchDriver
+// Vrai si le pilote ne satisfait pas le terme (sans score ni surlignage).
+func (n SearchNot) matchDriver(driver models.Driver) (searchMatch, bool) {
+	_, ok := n.Node.matchDriver(driver)
+	return searchMatch{}, !ok
+}
+
+// matchConstructor
+// Vrai si l'écurie ne satisfait pas le terme (sans score ni surlignage).
+func (n SearchNot) matchConstructor(constructor models.Constructor) (searchMatch, bool) {
+	_, ok := n.Node.matchConstructor(constructor)
+	return searchMatch{}, !ok
+}
+
+// String
+// Représentation normalisée du nœud.
+func (n SearchNot) String() string {
+	return "-" + n.Node.String()
+}
+
+// matchDriver
+// Cherche le texte dans le code, le numéro, le nom, le nom complet, le prénom, l'écurie et la nationalité
+// (du plus au moins pertinent).
+func (n SearchTerm) matchDriver(driver models.Driver) (searchMatch, bool) {
+	return n.matchFields([]searchTextField{
+		{key: "code", value: driver.Code, weight: 100},
+		{key: "permanentNumber", value: driver.PermanentNumber, weight: 100},
+		{key: "familyName", value: driver.FamilyName, weight: 90, fuzzy: true},
+		{key: "fullName", value: driver.GivenName + " " + driver.FamilyName, weight: 80},
+		{key: "givenName", value: driver.GivenName, weight: 60, fuzzy: true},
+		{key: "team", value: driver.Team, weight: 50, fuzzy: true},
+		{key: "nationality", value: driver.Nationality, weight: 20, fuzzy: true},
+	})
+}
+
+// matchConstructor
+// Cherche le texte dans le nom puis la nationalité de l'écurie.
+func (n SearchTerm) matchConstructor(constructor models.Constructor) (searchMatch, bool) {
+	return n.matchFields([]searchTextField{
+		{key: "name", value: constructor.Name, weight: 90, fuzzy: true},
+		{key: "nationality", value: constructor.Nationality, weight: 20, fuzzy: true},
+	})
+}
+
+// matchFields
+// Compare le texte à chaque champ : le meilleur champ donne le score, tous les champs correspondants sont surlignés
+// (fautes de frappe tolérées pour un mot, jamais pour une phrase).
+func (n SearchTerm) matchFields(fields []searchTextField) (searchMatch, bool) {
+	match := searchMatch{}
+	found := false
+	for _, field := range fields {
+		text := matchSearchText(n.Text, field.value, field.fuzzy && !n.Phrase)
+		if text.quality == searchMatchNone {
+			continue
+		}
+		found = true
+		match.score = max(match.score, textMatchScore(text, field.weight))
+		match.add(searchMatch{spans: map[string][]searchSpan{field.key: text.spans}})
+	}
+	return match, found
+}
+
+// String
+// Représentation normalisée du nœud (phrase entre guillemets).
+func (n SearchTerm) String() string {
+	if n.Phrase {
+		return strconv.Quote(n.Text)
+	}
+	return n.Text
+}
+
+// matchDriver
+// Vrai si le champ du pilote satisfait le filtre.
+func (n SearchField) matchDriver(driver models.Driver) (searchMatch, bool) {
+	field := searchFields[n.Field]
+	if field.driver == nil {
+		return searchMatch{}, false
+	}
+	return field.match(field.driver(driver), n.Op, n.Value, field.driverKey)
+}
+
+// matchConstructor
+// Vrai si le champ de l'écurie satisfait le filtre.
+func (n SearchField) matchConstructor(constructor models.Constructor) (searchMatch, bool) {
+	field := searchFields[n.Field]
+	if field.constructor == nil {
+		return searchMatch{}, false
+	}
+	return field.match(field.constructor(constructor), n.Op, n.Value, field.constructorKey)
+}
+
+// String
+// Représentation normalisée du nœud (valeur entre guillemets si elle contient un espace).
+func (n SearchField) String() string {
+	value := n.Value
+	if strings.ContainsAny(value, " \t") {
+		value = strconv.Quote(value)
+	}
+	if n.Op == SearchOpEqual {
+		return n.Field + ":" + value
+	}
+	return n.Field + ":" + n.Op + value
+}
+
+// match
+// Compare la valeur d'un élément à celle du filtre selon le type du champ, puis calcule le score et le surlignage
+// (valeur entière pour un nombre, une date ou un identifiant ; texte inclus sinon, sans accents ni casse mais sans tolérer
+// de faute de frappe : un champ est un filtre, seuls les termes libres sont approchés).
+func (f searchField) match(actual, op, value, key string) (searchMatch, bool) {
+	matched := false
+	switch {
+	case f.numeric:
+		number, err := strconv.Atoi(actual)
+		expected, _ := strconv.Atoi(value)
+		matched = err == nil && compareOrdered(number, expected, op)
+	case f.date:
+		// Une date partielle (2000, 2000-05) compare la date de naissance à la même précision.
+		matched = len(actual) >= len(value) && compareOrdered(actual[:len(value)], value, op)
+	case f.exact:
+		matched = foldSearchText(actual) == foldSearchText(value)
+	default:
+		text := matchSearchText(value, actual, false)
+		if text.quality == searchMatchNone {
+			return searchMatch{}, false
+		}
+		return searchMatch{score: textMatchScore(text, f.weight), spans: searchSpans(key, text.spans)}, true
+	}
+	if !matched {
+		return searchMatch{}, false
+	}
+	return searchMatch{score: f.weight, spans: searchSpans(key, []searchSpan{{start: 0, end: len([]rune(actual))}})}, true
+}
+
+// textMatchScore
+// Score d'une correspondance de texte : part du poids du champ selon la qualité, moins une pénalité par faute.
+func textMatchScore(text textMatch, weight int) int {
+	return max(weight*searchMatchPercent[text.quality]/100-text.distance*searchFuzzyPenalty, 1)
+}
+
+// searchSpans
+// Associe des portions à surligner à la clé d'un champ affiché (aucune si le champ n'est pas affiché).
+func searchSpans(key string, spans []searchSpan) map[string][]searchSpan {
+	if key == "" {
+		return nil
+	}
+	return map[string][]searchSpan{key: spans}
+}
+
+// matchAll
+// Vrai si tous les nœuds correspondent ; additionne leurs scores et leurs portions.
+func matchAll(nodes []SearchNode, match func(SearchNode) (searchMatch, bool)) (searchMatch, bool) {
+	result := searchMatch{}
+	for _, node := range nodes {
+		nodeMatch, ok := match(node)
+		if !ok {
+			return searchMatch{}, false
+		}
+		result.add(nodeMatch)
+	}
+	return result, true
+}
+
+// matchAny
+// Vrai si au moins un nœud correspond ; additionne les scores et les portions des nœuds correspondants.
+func matchAny(nodes []SearchNode, match func(SearchNode) (searchMatch, bool)) (searchMatch, bool) {
+	result := searchMatch{}
+	found := false
+	for _, node := range nodes {
+		if nodeMatch, ok := match(node); ok {
+			result.add(nodeMatch)
+			found = true
+		}
+	}
+	return result, found
+}
+
+// compareOrdered
+// Applique un opérateur de comparaison (":" vaut égalité).
+func compareOrdered[T int | string](actual, expected T, op string) bool {
+	switch op {
+	case SearchOpLess:
+		return actual < expected
+	case SearchOpLessEqual:
+		return actual <= expected
+	case SearchOpGreater:
+		return actual > expected
+	case SearchOpGreaterEqual:
+		return actual >= expected
+	default:
+		return actual == expected
+	}
+}
+
+// joinSearchNodes
+// Joint la représentation de plusieurs nœuds avec un séparateur.
+func joinSearchNodes(nodes []SearchNode, separator string) string {
+	parts := make([]string, len(nodes))
+	for i, node := range nodes {
+		parts[i] = node.String()
+	}
+	return strings.Join(parts, separator)
+}
+
+// Types de lexèmes d'une requête de recherche.
+const (
+	searchTokenWord = iota
+	searchTokenPhrase
+	searchTokenField
+	searchTokenNot
+	searchTokenOr
+	searchTokenAnd
+	searchTokenOpen
+	searchTokenClose
+)
+
+// searchToken
+// Lexème d'une requête : type, texte, filtre de champ éventuel et position (en caractères) dans la requête.
+type searchToken struct {
+	kind     int
+	text     string
+	field    SearchField
+	position int
+	length   int
+}
+
+// ParseSearchQuery
+// ----------------
+// Objectif :
+//   - Analyser une requête de recherche en arbre syntaxique :
+//     mots et "phrases" (texte libre), filtres champ:valeur (team:ferrari, type:"test driver", number:44, born:<2000),
+//     négation (-team:haas), OR, AND (implicite entre deux termes) et parenthèses.
+//   - Retourner nil pour une requête vide, et une *SearchSyntaxError localisée en cas d'erreur de syntaxe.
+func ParseSearchQuery(query string) (SearchNode, error) {
+
+	// Étape 1 : Vérifier la taille de la requête.
+	if length := len([]rune(query)); length > searchQueryMaxLength {
+		return nil, &SearchSyntaxError{Query: query, Position: searchQueryMaxLength, Length: length - searchQueryMaxLength,
+			Message: fmt.Sprintf("requête trop longue (%d caractères maximum)", searchQueryMaxLength)}
+	}
+
+	// Étape 2 : Découper la requête en lexèmes.
+	tokens, err := tokenizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	// Étape 3 : Construire l'arbre (OR de AND de termes éventuellement niés).
+	parser := &searchParser{query: query, tokens: tokens}
+	node, err := parser.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := parser.peek(); ok {
+		return nil, parser.errorAt(token, "parenthèse fermante sans parenthèse ouvrante")
+	}
+	return node, nil
+}
+
+// tokenizeSearchQuery
+// Découpe une requête en lexèmes (mots, phrases, filtres de champ, -, OR, AND, parenthèses).
+func tokenizeSearchQuery(query string) ([]searchToken, error) {
+	runes := []rune(query)
+	tokens := []searchToken{}
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, searchToken{kind: searchTokenOpen, text: "(", position: i, length: 1})
+			i++
+		case r == ')':
+			tokens = append(tokens, searchToken{kind: searchTokenClose, text: ")", position: i, length: 1})
+			i++
+		case r == '"':
+			text, end, err := readSearchPhrase(query, runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, searchToken{kind: searchTokenPhrase, text: text, position: i, length: end - i})
+			i = end
+		case r == '-':
+			if i+1 >= len(runes) || unicode.IsSpace(runes[i+1]) || runes[i+1] == ')' {
+				return nil, &SearchSyntaxError{Query: query, Position: i, Length: 1, Message: "« - » doit précéder un terme à exclure (ex : -team:haas)"}
+			}
+			tokens = append(tokens, searchToken{kind: searchTokenNot, text: "-", position: i, length: 1})
+			i++
+		default:
+			token, end, err := readSearchWord(query, runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// readSearchPhrase
+// Lit une phrase entre guillemets à partir de la position start et retourne son texte et la position suivante.
+func readSearchPhrase(query string, runes []rune, start int) (string, int, error) {
+	end := start + 1
+	for end < len(runes) && runes[end] != '"' {
+		end++
+	}
+	if end >= len(runes) {
+		return "", 0, &SearchSyntaxError{Query: query, Position: start, Length: len(runes) - start, Message: "guillemet fermant manquant"}
+	}
+	text := strings.TrimSpace(string(runes[start+1 : end]))
+	if text == "" {
+		return "", 0, &SearchSyntaxError{Query: query, Position: start, Length: end + 1 - start, Message: "phrase vide entre guillemets"}
+	}
+	return text, end + 1, nil
+}
+
+// readSearchWord
+// Lit un mot, un mot-clé (OR, AND) ou un filtre champ:valeur (valeur éventuellement entre guillemets).
+func readSearchWord(query string, runes []rune, start int) (searchToken, int, error) {
+	end := start
+	for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`()"`, runes[end]) {
+		end++
+	}
+	word := string(runes[start:end])
+	token := searchToken{kind: searchTokenWord, text: word, position: start, length: end - start}
+
+	// Étape 1 : Mots-clés (en majuscules, pour ne pas gêner la recherche du mot « or »).
+	switch word {
+	case "OR":
+		token.kind = searchTokenOr
+		return token, end, nil
+	case "AND":
+		token.kind = searchTokenAnd
+		return token, end, nil
+	}
+
+	// Étape 2 : Filtre de champ (nom de champ suivi de ":").
+	name, rest, isField := strings.Cut(word, ":")
+	if !isField || !searchFieldNamePattern.MatchString(name) {
+		return token, end, nil
+	}
+	fieldName := strings.ToLower(name)
+	if alias, ok := searchFieldAliases[fieldName]; ok {
+		fieldName = alias
+	}
+	field, known := searchFields[fieldName]
+	nameLength := len([]rune(name))
+	if !known {
+		return token, 0, &SearchSyntaxError{Query: query, Position: start, Length: nameLength,
+			Message: fmt.Sprintf("champ inconnu « %s » (champs : %s)", name, strings.Join(SearchFieldNames(), ", "))}
+	}
+
+	// Étape 3 : Opérateur de comparaison éventuel, puis valeur (reste du mot ou phrase entre guillemets).
+	op := SearchOpEqual
+	for _, candidate := range []string{SearchOpLessEqual, SearchOpGreaterEqual, SearchOpLess, SearchOpGreater} {
+		if strings.HasPrefix(rest, candidate) {
+			op = candidate
+			rest = strings.TrimPrefix(rest, candidate)
+			break
+		}
+	}
+	value := rest
+	if value == "" && end < len(runes) && runes[end] == '"' {
+		phrase, phraseEnd, err := readSearchPhrase(query, runes, end)
+		if err != nil {
+			return token, 0, err
+		}
+		value, end = phrase, phraseEnd
+		token.length = end - start
+	}
+	if value == "" {
+		return token, 0, &SearchSyntaxError{Query: query, Position: start, Length: end - start,
+			Message: fmt.Sprintf("valeur manquante après « %s: »", name)}
+	}
+	if err := validateSearchFieldValue(fieldName, field, op, value); err != nil {
+		return token, 0, &SearchSyntaxError{Query: query, Position: start, Length: token.length, Message: err.Error()}
+	}
+	token.kind = searchTokenField
+	token.field = SearchField{Field: fieldName, Op: op, Value: value}
+	return token, end, nil
+}
+
+// validateSearchFieldValue
+// Vérifie que l'opérateur et la valeur conviennent au type du champ (nombre, date ou texte).
+func validateSearchFieldValue(name string, field searchField, op, value string) error {
+	switch {
+	case field.numeric:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%s attend un nombre (ex : %s:44, %s:<10)", name, name, name)
+		}
+	case field.date:
+		if !isSearchDate(value) {
+			return fmt.Errorf("%s attend une date AAAA, AAAA-MM ou AAAA-MM-JJ (ex : %s:<2000)", name, name)
+		}
+	case op != SearchOpEqual:
+		return fmt.Errorf("l'opérateur %s ne s'applique qu'aux nombres et aux dates (number, born), pas à %s", op, name)
+	}
+	return nil
+}
+
+// isSearchDate
+// Indique si une valeur est une date complète ou partielle (2000, 2000-05, 2000-05-17).
+func isSearchDate(value string) bool {
+	for _, layout := range []string{"2006", "2006-01", "2006-01-02"} {
+		if len(value) == len(layout) {
+			if _, err := time.Parse(layout, value); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// searchParser
+// Analyseur descendant récursif des lexèmes d'une requête.
+type searchParser struct {
+	query  string
+	tokens []searchToken
+	next   int
+}
+
+// peek
+// Retourne le lexème courant sans l'avancer (false en fin de requête).
+func (p *searchParser) peek() (searchToken, bool) {
+	if p.next >= len(p.tokens) {
+		return searchToken{}, false
+	}
+	return p.tokens[p.next], true
+}
+
+// errorAt
+// Construit une erreur de syntaxe située sur un lexème.
+func (p *searchParser) errorAt(token searchToken, message string) error {
+	return &SearchSyntaxError{Query: p.query, Position: token.position, Length: token.length, Message: message}
+}
+
+// errorAtEnd
+// Construit une erreur de syntaxe située en fin de requête.
+func (p *searchParser) errorAtEnd(message string) error {
+	return &SearchSyntaxError{Query: p.query, Position: len([]rune(p.query)), Message: message}
+}
+
+// parseOr
+// Analyse une suite de groupes séparés par OR.
+func (p *searchParser) parseOr(depth int) (SearchNode, error) {
+	nodes := []SearchNode{}
+	for {
+		if token, ok := p.peek(); ok && token.kind == searchTokenOr {
+			return nil, p.errorAt(token, "OR doit être placé entre deux termes")
+		}
+		node, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+
+		token, ok := p.peek()
+		if !ok || token.kind != searchTokenOr {
+			break
+		}
+		p.next++
+		if following, ok := p.peek(); !ok || following.kind == searchTokenClose {
+			return nil, p.errorAt(token, "OR doit être placé entre deux termes")
+		}
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return SearchOr{Nodes: nodes}, nil
+}
+
+// parseAnd
+// Analyse une suite de termes (AND implicite entre deux termes, ou explicite).
+func (p *searchParser) parseAnd(depth int) (SearchNode, error) {
+	nodes := []SearchNode{}
+	for {
+		token, ok := p.peek()
+		if !ok || token.kind == searchTokenOr || token.kind == searchTokenClose {
+			break
+		}
+		if token.kind == searchTokenAnd {
+			if len(nodes) == 0 {
+				return nil, p.errorAt(token, "AND doit être placé entre deux termes")
+			}
+			p.next++
+			if following, ok := p.peek(); !ok || following.kind == searchTokenOr || following.kind == searchTokenClose || following.kind == searchTokenAnd {
+				return nil, p.errorAt(token, "AND doit être placé entre deux termes")
+			}
+			continue
+		}
+		node, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 0 {
+		if token, ok := p.peek(); ok {
+			return nil, p.errorAt(token, "terme attendu")
+		}
+		return nil, p.errorAtEnd("terme attendu")
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return SearchAnd{Nodes: nodes}, nil
+}
+
+// parseUnary
+// Analyse un terme éventuellement nié : mot, phrase, filtre de champ ou groupe entre parenthèses.
+func (p *searchParser) parseUnary(depth int) (SearchNode, error) {
+	token, _ := p.peek()
+	p.next++
+	switch token.kind {
+	case searchTokenNot:
+		following, ok := p.peek()
+		if !ok || following.kind == searchTokenNot || following.kind == searchTokenOr || following.kind == searchTokenAnd || following.kind == searchTokenClose {
+			return nil, p.errorAt(token, "« - » doit précéder un terme à exclure (ex : -team:haas)")
+		}
+		node, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		return SearchNot{Node: node}, nil
+	case searchTokenOpen:
+		if depth >= searchQueryMaxDepth {
+			return nil, p.errorAt(token, fmt.Sprintf("trop de parenthèses imbriquées (%d niveaux maximum)", searchQueryMaxDepth))
+		}
+		if following, ok := p.peek(); ok && following.kind == searchTokenClose {
+			return nil, &SearchSyntaxError{Query: p.query, Position: token.position, Length: following.position + 1 - token.position, Message: "parenthèses vides"}
+		}
+		node, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing, ok := p.peek(); !ok || closing.kind != searchTokenClose {
+			return nil, p.errorAt(token, "parenthèse fermante manquante")
+		}
+		p.next++
+		return node, nil
+	case searchTokenField:
+		return token.field, nil
+	case searchTokenPhrase:
+		return SearchTerm{Text: token.text, Phrase: true}, nil
+	default:
+		return SearchTerm{Text: token.text}, nil
+	}
+}
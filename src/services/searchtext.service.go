@@ -0,0 +1,286 @@
+package services
+
+import (
+	"f1-app/models"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Qualité d'une correspondance de texte, de la plus forte à la plus faible.
+const (
+	searchMatchNone = iota
+	searchMatchFuzzy
+	searchMatchContains
+	searchMatchWordPrefix
+	searchMatchPrefix
+	searchMatchExact
+)
+
+// searchMatchPercent
+// Part du poids d'un champ accordée selon la qualité de la correspondance (en pourcentage).
+var searchMatchPercent = map[int]int{
+	searchMatchExact:      100,
+	searchMatchPrefix:     80,
+	searchMatchWordPrefix: 70,
+	searchMatchContains:   50,
+	searchMatchFuzzy:      40,
+}
+
+// searchFuzzyMinLength est la longueur minimale d'un mot pour tolérer des fautes de frappe.
+const searchFuzzyMinLength = 4
+
+// searchFoldSpecial
+// Lettres sans décomposition Unicode ramenées à leur équivalent latin simple.
+var searchFoldSpecial = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i",
+}
+
+// searchSpan
+// Portion [start, end) d'un texte, en caractères (runes) du texte d'origine.
+type searchSpan struct {
+	start int
+	end   int
+}
+
+// foldedText
+// Texte replié (minuscules, sans accents) avec, pour chaque octet replié, l'index du caractère d'origine.
+type foldedText struct {
+	text    string
+	origins []int
+	length  int
+}
+
+// textMatch
+// Résultat de la comparaison d'un terme avec un texte : qualité, distance d'édition et portions correspondantes.
+type textMatch struct {
+	quality  int
+	distance int
+	spans    []searchSpan
+}
+
+// foldSearchText
+// Replie un texte pour la comparaison : décomposition Unicode (NFD), suppression des accents, minuscules
+// ("Pérez" -> "perez", "Hülkenberg" -> "hulkenberg").
+func foldSearchText(value string) string {
+	return foldSearchTextWithOrigins(value).text
+}
+
+// foldSearchTextWithOrigins
+// Replie un texte caractère par caractère en conservant la position d'origine de chaque octet replié
+// (pour surligner le texte d'origine à partir d'une correspondance dans le texte replié).
+func foldSearchTextWithOrigins(value string) foldedText {
+	var builder strings.Builder
+	origins := []int{}
+	index := 0
+	for _, r := range value {
+		folded := foldSearchRune(r)
+		builder.WriteString(folded)
+		for range len(folded) {
+			origins = append(origins, index)
+		}
+		index++
+	}
+	return foldedText{text: builder.String(), origins: origins, length: index}
+}
+
+// foldSearchRune
+// Replie un caractère (sans accent, en minuscule ; un accent isolé disparaît).
+func foldSearchRune(r rune) string {
+	lower := unicode.ToLower(r)
+	if special, ok := searchFoldSpecial[lower]; ok {
+		return special
+	}
+	if lower < unicode.MaxASCII {
+		return string(lower)
+	}
+	var builder strings.Builder
+	for _, part := range norm.NFD.String(string(lower)) {
+		if !unicode.Is(unicode.Mn, part) {
+			builder.WriteRune(part)
+		}
+	}
+	return builder.String()
+}
+
+// originSpan
+// Convertit une portion [start, end) du texte replié en portion du texte d'origine
+// (étendue aux accents isolés qui suivent le dernier caractère).
+func (f foldedText) originSpan(start, end int) searchSpan {
+	if start >= end || start >= len(f.origins) {
+		return searchSpan{}
+	}
+	last := f.origins[end-1] + 1
+	if end == len(f.origins) {
+		last = f.length
+	} else if next := f.origins[end]; next > last {
+		last = next
+	}
+	return searchSpan{start: f.origins[start], end: last}
+}
+
+// matchSearchText
+// ---------------
+// Objectif :
+//   - Comparer un terme de recherche à un texte, sans tenir compte de la casse ni des accents.
+//   - Qualifier la correspondance : texte identique, début du texte, début d'un mot, inclusion,
+//     ou mot proche à quelques fautes près (si fuzzy : 1 faute dès 4 lettres, 2 dès 7 lettres).
+//   - Retourner les portions du texte d'origine à surligner.
+func matchSearchText(term, value string, fuzzy bool) textMatch {
+	foldedTerm := foldSearchText(term)
+	folded := foldSearchTextWithOrigins(value)
+	if foldedTerm == "" || folded.text == "" {
+		return textMatch{}
+	}
+
+	// Étape 1 : Texte identique.
+	if folded.text == foldedTerm {
+		return textMatch{quality: searchMatchExact, spans: []searchSpan{{start: 0, end: folded.length}}}
+	}
+
+	// Étape 2 : Occurrences du terme dans le texte (la meilleure position donne la qualité).
+	match := textMatch{}
+	for offset := 0; offset <= len(folded.text)-len(foldedTerm); {
+		found := strings.Index(folded.text[offset:], foldedTerm)
+		if found < 0 {
+			break
+		}
+		start := offset + found
+		quality := searchMatchContains
+		if start == 0 {
+			quality = searchMatchPrefix
+		} else if isSearchWordBoundary(folded.text, start) {
+			quality = searchMatchWordPrefix
+		}
+		match.quality = max(match.quality, quality)
+		match.spans = append(match.spans, folded.originSpan(start, start+len(foldedTerm)))
+		offset = start + len(foldedTerm)
+	}
+	if match.quality != searchMatchNone || !fuzzy {
+		return match
+	}
+
+	// Étape 3 : Mot proche (faute de frappe), comparé au mot entier ou à son début.
+	allowed := searchTypoAllowance(foldedTerm)
+	if allowed == 0 {
+		return match
+	}
+	best := allowed + 1
+	for _, word := range searchWords(folded.text) {
+		text := folded.text[word.start:word.end]
+		distance := searchEditDistance(foldedTerm, text)
+		if termLength := len([]rune(foldedTerm)); len([]rune(text)) > termLength+allowed {
+			distance = min(distance, searchEditDistance(foldedTerm, string([]rune(text)[:termLength])))
+		}
+		if distance > allowed {
+			continue
+		}
+		if distance < best {
+			best = distance
+			match.spans = nil
+		}
+		if distance == best {
+			match.spans = append(match.spans, folded.originSpan(word.start, word.end))
+		}
+	}
+	if best <= allowed {
+		match.quality = searchMatchFuzzy
+		match.distance = best
+	}
+	return match
+}
+
+// searchTypoAllowance
+// Nombre de fautes tolérées pour un terme replié (aucune pour un terme court ou contenant des chiffres).
+func searchTypoAllowance(term string) int {
+	length := len([]rune(term))
+	switch {
+	case length < searchFuzzyMinLength || strings.IndexFunc(term, unicode.IsDigit) >= 0:
+		return 0
+	case length < 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// searchWords
+// Découpe un texte replié en mots (lettres et chiffres), en positions d'octets.
+func searchWords(text string) []searchSpan {
+	words := []searchSpan{}
+	start := -1
+	for i, r := range text {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWordRune && start < 0 {
+			start = i
+		} else if !isWordRune && start >= 0 {
+			words = append(words, searchSpan{start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, searchSpan{start: start, end: len(text)})
+	}
+	return words
+}
+
+// isSearchWordBoundary
+// Indique si la position (en octets) d'un texte replié commence un mot.
+func isSearchWordBoundary(text string, position int) bool {
+	last, _ := utf8.DecodeLastRuneInString(text[:position])
+	return !unicode.IsLetter(last) && !unicode.IsDigit(last)
+}
+
+// searchEditDistance
+// Distance de Damerau-Levenshtein restreinte (insertion, suppression, substitution, inversion de deux lettres voisines).
+func searchEditDistance(a, b string) int {
+	left, right := []rune(a), []rune(b)
+	rows := make([][]int, len(left)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(right)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(left); i++ {
+		for j := 1; j <= len(right); j++ {
+			cost := 1
+			if left[i-1] == right[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && left[i-1] == right[j-2] && left[i-2] == right[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(left)][len(right)]
+}
+
+// highlightSegments
+// Découpe un texte en morceaux surlignés ou non d'après des portions (fusionnées si elles se chevauchent).
+func highlightSegments(value string, spans []searchSpan) []models.HighlightSegment {
+	runes := []rune(value)
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	segments := []models.HighlightSegment{}
+	position := 0
+	for _, span := range spans {
+		start, end := max(span.start, position), min(span.end, len(runes))
+		if start >= end {
+			continue
+		}
+		if start > position {
+			segments = append(segments, models.HighlightSegment{Text: string(runes[position:start])})
+		}
+		segments = append(segments, models.HighlightSegment{Text: string(runes[start:end]), Match: true})
+		position = end
+	}
+	if position < len(runes) {
+		segments = append(segments, models.HighlightSegment{Text: string(runes[position:])})
+	}
+	return segments
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+)
+
+// DefaultSeason est la saison affichée lorsqu'aucune n'est précisée.
+const DefaultSeason = "2025"
+
+// ResolveSeason
+// Retourne la saison demandée, ou la saison par défaut si le paramètre est vide.
+func ResolveSeason(season string) string {
+	if season == "" {
+		return DefaultSeason
+	}
+	return season
+}
+
+// GetSeasons
+// Retourne les saisons disponibles auprès de la source de données (la saison par défaut en cas d'erreur).
+func GetSeasons() []string {
+	seasons, err := driverSource.Seasons()
+	if err != nil || len(seasons) == 0 {
+		return []string{DefaultSeason}
+	}
+	return seasons
+}
+
+// sourceErrorStatus
+// Traduit une erreur de la source de données en code HTTP (404 pour une saison inconnue, 502 sinon).
+func sourceErrorStatus(err error) int {
+	if errors.Is(err, ErrSeasonNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadGateway
+}
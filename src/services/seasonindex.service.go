@@ -0,0 +1,270 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"f1-app/models"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// seasonIndexTTL est l'âge au-delà duquel l'index d'une saison est revérifié auprès de la source de données.
+const seasonIndexTTL = 10 * time.Minute
+
+// seasonIndex
+// Index d'une saison, partagé par les services (en lecture seule) : listes des pilotes et des écuries (dans l'ordre de
+// la source), pilotes et écuries par identifiant, pilotes de chaque écurie (rattachés par Driver.ConstructorID, dans l'ordre de la liste des pilotes), arbre des préfixes de
+// l'autocomplétion, classements (calculés à la première demande, voir standingsOf), empreinte des données et date de
+// construction.
+type seasonIndex struct {
+	drivers              []models.Driver
+	constructors         []models.Constructor
+	driversByID          map[string]models.Driver
+	constructorsByID     map[string]models.Constructor
+	driversByConstructor map[string][]models.Driver
+	suggest              *suggestIndex
+	fingerprint          string
+	builtAt              time.Time
+
+	standingsMutex sync.Mutex
+	standings      *seasonStandings
+}
+
+// IntegrityIssue
+// Anomalie relevée dans les données d'une saison : pilote sans écurie ou rattaché à une écurie inconnue.
+type IntegrityIssue struct {
+	Season        string
+	DriverID      string
+	ConstructorID string
+}
+
+// seasonIndexes conserve l'index de chaque saison (construit à la première demande).
+var (
+	seasonIndexes    = map[string]*seasonIndex{}
+	seasonRefreshing = map[string]bool{}
+	seasonIndexMutex sync.Mutex
+)
+
+// InvalidateSeasonIndexes
+// Oublie les index des saisons, autocomplétion et classements compris (reconstruits à la prochaine demande), par
+// exemple après un changement de source.
+func InvalidateSeasonIndexes() {
+	seasonIndexMutex.Lock()
+	defer seasonIndexMutex.Unlock()
+	seasonIndexes = map[string]*seasonIndex{}
+}
+
+// seasonIndexFor
+// --------------
+// Objectif :
+//   - Retourner l'index de la saison, construit à la première demande.
+//   - Au-delà de seasonIndexTTL, continuer à servir l'index existant et le revérifier en arrière-plan (une seule
+//     revérification à la fois par saison) : il n'est remplacé que si les données de la source ont changé.
+func seasonIndexFor(season string) (*seasonIndex, error) {
+	seasonIndexMutex.Lock()
+	index := seasonIndexes[season]
+	stale := index != nil && time.Since(index.builtAt) > seasonIndexTTL && !seasonRefreshing[season]
+	if stale {
+		seasonRefreshing[season] = true
+	}
+	seasonIndexMutex.Unlock()
+
+	// Étape 1 : Index à jour, ou revérifié en arrière-plan.
+	if index != nil {
+		if stale {
+			go refreshSeasonIndex(season, index)
+		}
+		return index, nil
+	}
+
+	// Étape 2 : Première demande : construire l'index.
+	built, err := buildSeasonIndex(season)
+	if err != nil {
+		return nil, err
+	}
+	seasonIndexMutex.Lock()
+	seasonIndexes[season] = built
+	seasonIndexMutex.Unlock()
+	return built, nil
+}
+
+// refreshSeasonIndex
+// Reconstruit l'index d'une saison en arrière-plan (classements compris s'ils avaient été calculés) et ne le remplace
+// que si les données ont changé.
+func refreshSeasonIndex(season string, current *seasonIndex) {
+	built, err := buildSeasonIndex(season)
+	standingsChanged := false
+	if previous := current.loadedStandings(); err == nil && previous != nil {
+		standings, standingsErr := built.standingsOf(season)
+		if standingsErr != nil {
+			log.Printf("erreur actualisation classements de la saison %s: %v", season, standingsErr)
+		}
+		standingsChanged = standingsErr == nil && standings.fingerprint != previous.fingerprint
+	}
+	seasonIndexMutex.Lock()
+	defer seasonIndexMutex.Unlock()
+	delete(seasonRefreshing, season)
+	if err != nil {
+		log.Printf("erreur actualisation index de la saison %s: %v", season, err)
+		return
+	}
+	if seasonIndexes[season] != current {
+		return // index oublié ou remplacé entre-temps
+	}
+	if built.fingerprint == current.fingerprint && !standingsChanged {
+		current.builtAt = built.builtAt
+		return
+	}
+	seasonIndexes[season] = built
+}
+
+// buildSeasonIndex
+// ----------------
+// Objectif :
+//   - Charger les pilotes et écuries d'une saison depuis la source de données.
+//   - Indexer les pilotes et les écuries par identifiant (le premier l'emporte en cas de doublon).
+//   - Regrouper les pilotes par écurie selon leur ConstructorID (sans comparer les noms d'équipe).
+//   - Construire l'arbre des préfixes de l'autocomplétion et calculer l'empreinte des données (revérifications).
+func buildSeasonIndex(season string) (*seasonIndex, error) {
+	drivers, err := driverSource.Drivers(season)
+	if err != nil {
+		return nil, err
+	}
+	constructors, err := driverSource.Constructors(season)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &seasonIndex{
+		drivers:              drivers,
+		constructors:         constructors,
+		driversByID:          make(map[string]models.Driver, len(drivers)),
+		constructorsByID:     make(map[string]models.Constructor, len(constructors)),
+		driversByConstructor: make(map[string][]models.Driver, len(constructors)),
+		builtAt:              time.Now(),
+	}
+
+	// Étape 1 : Indexer les écuries par identifiant.
+	for _, constructor := range constructors {
+		if _, ok := index.constructorsByID[constructor.ConstructorID]; !ok {
+			index.constructorsByID[constructor.ConstructorID] = constructor
+		}
+	}
+
+	// Étape 2 : Indexer les pilotes et les regrouper par écurie.
+	for _, driver := range drivers {
+		if _, ok := index.driversByID[driver.DriverID]; !ok {
+			index.driversByID[driver.DriverID] = driver
+		}
+		if driver.ConstructorID != "" {
+			index.driversByConstructor[driver.ConstructorID] = append(index.driversByConstructor[driver.ConstructorID], driver)
+		}
+	}
+
+	// Étape 3 : Construire l'autocomplétion et l'empreinte des données.
+	index.suggest = newSuggestIndex(season, drivers, constructors)
+	index.fingerprint, err = dataFingerprint([]interface{}{drivers, constructors})
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// dataFingerprint
+// Calcule l'empreinte (SHA-256 du JSON) de données de la source, comparée lors des revérifications.
+func dataFingerprint(data interface{}) (string, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// standingsOf
+// Retourne les classements de la saison, calculés à la première demande puis conservés avec l'index (un seul calcul
+// à la fois ; un échec n'est pas conservé et sera retenté à la demande suivante).
+func (index *seasonIndex) standingsOf(season string) (*seasonStandings, error) {
+	index.standingsMutex.Lock()
+	defer index.standingsMutex.Unlock()
+	if index.standings == nil {
+		standings, err := computeSeasonStandings(season, index)
+		if err != nil {
+			return nil, err
+		}
+		index.standings = standings
+	}
+	return index.standings, nil
+}
+
+// loadedStandings
+// Retourne les classements déjà calculés de la saison (nil s'ils n'ont jamais été demandés).
+func (index *seasonIndex) loadedStandings() *seasonStandings {
+	index.standingsMutex.Lock()
+	defer index.standingsMutex.Unlock()
+	return index.standings
+}
+
+// driverList
+// Retourne une copie de la liste des pilotes de la saison (triable et filtrable sans modifier l'index).
+func (index *seasonIndex) driverList() []models.Driver {
+	return append([]models.Driver{}, index.drivers...)
+}
+
+// constructorList
+// Retourne une copie de la liste des écuries de la saison (triable et filtrable sans modifier l'index).
+func (index *seasonIndex) constructorList() []models.Constructor {
+	return append([]models.Constructor{}, index.constructors...)
+}
+
+// driversOf
+// Retourne une copie des pilotes rattachés à une écurie (liste vide si elle n'en a aucun).
+func (index *seasonIndex) driversOf(constructorID string) []models.Driver {
+	return append([]models.Driver{}, index.driversByConstructor[constructorID]...)
+}
+
+// issues
+// Retourne les pilotes de la saison sans écurie ou rattachés à une écurie absente de la saison.
+func (index *seasonIndex) issues(season string) []IntegrityIssue {
+	issues := []IntegrityIssue{}
+	for _, driver := range index.drivers {
+		if _, ok := index.constructorsByID[driver.ConstructorID]; !ok {
+			issues = append(issues, IntegrityIssue{Season: season, DriverID: driver.DriverID, ConstructorID: driver.ConstructorID})
+		}
+	}
+	return issues
+}
+
+// String
+// Décrit l'anomalie pour les journaux de démarrage.
+func (issue IntegrityIssue) String() string {
+	if issue.ConstructorID == "" {
+		return fmt.Sprintf("saison %s : le pilote %s n'est rattaché à aucune écurie", issue.Season, issue.DriverID)
+	}
+	return fmt.Sprintf("saison %s : le pilote %s est rattaché à une écurie inconnue (%s)", issue.Season, issue.DriverID, issue.ConstructorID)
+}
+
+// ValidateDataIntegrity
+// ---------------------
+// Objectif :
+//   - Vérifier, pour chaque saison disponible, que chaque pilote référence une écurie existante de la saison.
+//   - Retourner toutes les anomalies relevées (appelé au démarrage, les anomalies sont journalisées sans bloquer).
+//   - Construire au passage l'index de chaque saison, partagé ensuite par les services.
+func ValidateDataIntegrity() ([]IntegrityIssue, error) {
+	issues := []IntegrityIssue{}
+	seasons, err := driverSource.Seasons()
+	if err != nil {
+		return issues, err
+	}
+	for _, season := range seasons {
+		index, err := seasonIndexFor(season)
+		if err != nil {
+			return issues, fmt.Errorf("saison %s : %w", season, err)
+		}
+		issues = append(issues, index.issues(season)...)
+	}
+	return issues, nil
+}
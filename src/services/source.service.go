@@ -0,0 +1,302 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"f1-app/models"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultErgastBaseURL est l'adresse publique de l'API Ergast (miroir Jolpica).
+const DefaultErgastBaseURL = "https://api.jolpi.ca/ergast"
+
+// ergastPageSize correspond à la limite maximale acceptée par l'API pour une page.
+const ergastPageSize = 100
+
+// ErrSeasonNotFound est retournée par une source lorsqu'elle ne dispose d'aucune donnée pour la saison demandée.
+var ErrSeasonNotFound = errors.New("saison introuvable")
+
+// DriverSource
+// Interface d'accès aux pilotes et écuries d'une saison, quelle que soit l'origine des données.
+type DriverSource interface {
+	Seasons() ([]string, error)
+	Drivers(season string) ([]models.Driver, error)
+	Constructors(season string) ([]models.Constructor, error)
+}
+
+// driverSource est la source utilisée par les services (données intégrées par défaut).
+var driverSource DriverSource = EmbeddedSource{}
+
+// UseDriverSource
+// Remplace la source de données utilisée par les services (ignorée si nil) et oublie les index des saisons.
+func UseDriverSource(source DriverSource) {
+	if source != nil {
+		driverSource = source
+		InvalidateSeasonIndexes()
+	}
+}
+
+// EmbeddedSource
+// Source de données s'appuyant sur les saisons intégrées au binaire (models.SeasonsData).
+type EmbeddedSource struct{}
+
+// Seasons
+// Retourne les saisons intégrées, de la plus récente à la plus ancienne.
+func (EmbeddedSource) Seasons() ([]string, error) {
+	seasons := make([]string, 0, len(models.SeasonsData))
+	for season := range models.SeasonsData {
+		seasons = append(seasons, season)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(seasons)))
+	return seasons, nil
+}
+
+// Drivers
+// Retourne les pilotes intégrés de la saison, ou ErrSeasonNotFound.
+func (EmbeddedSource) Drivers(season string) ([]models.Driver, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Drivers, nil
+}
+
+// Constructors
+// Retourne les écuries intégrées de la saison, ou ErrSeasonNotFound.
+func (EmbeddedSource) Constructors(season string) ([]models.Constructor, error) {
+	data, ok := models.SeasonsData[season]
+	if !ok {
+		return nil, ErrSeasonNotFound
+	}
+	return data.Constructors, nil
+}
+
+// ErgastSource
+// Source de données interrogeant l'API Ergast/Jolpica en HTTP.
+// Les champs propres au site (image, équipe, type de pilote...) sont complétés depuis Overlay.
+type ErgastSource struct {
+	BaseURL  string
+	Client   *http.Client
+	PageSize int
+	Overlay  DriverSource
+}
+
+// NewErgastSource
+// Construit une source Ergast avec un client HTTP temporisé et les données intégrées en surcouche.
+func NewErgastSource(baseURL string) *ErgastSource {
+	if baseURL == "" {
+		baseURL = DefaultErgastBaseURL
+	}
+	return &ErgastSource{
+		BaseURL:  strings.TrimRight(baseURL, "/"),
+		Client:   &http.Client{Timeout: 10 * time.Second},
+		PageSize: ergastPageSize,
+		Overlay:  EmbeddedSource{},
+	}
+}
+
+// Seasons
+// Retourne les saisons proposées sur le site : celles de la surcouche, pour lesquelles les pages sont complètes.
+func (s *ErgastSource) Seasons() ([]string, error) {
+	if s.Overlay == nil {
+		return EmbeddedSource{}.Seasons()
+	}
+	return s.Overlay.Seasons()
+}
+
+// Drivers
+// -------
+// Objectif :
+//   - Récupérer toutes les pages de /f1/{season}/drivers depuis l'API.
+//   - Compléter chaque pilote avec les champs de la surcouche (image, équipe et son identifiant, type).
+//   - Ajouter les pilotes connus seulement de la surcouche (essai, réserve).
+//   - Retourner ErrSeasonNotFound si l'API ne connaît aucun pilote pour la saison.
+func (s *ErgastSource) Drivers(season string) ([]models.Driver, error) {
+
+	// Étape 1 : Parcourir toutes les pages de la table des pilotes.
+	var drivers []models.Driver
+	err := s.fetchAll(season, "drivers", func(data models.MRData) int {
+		if data.DriverTable == nil {
+			return 0
+		}
+		drivers = append(drivers, data.DriverTable.Drivers...)
+		return len(data.DriverTable.Drivers)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(drivers) == 0 {
+		return nil, ErrSeasonNotFound
+	}
+
+	// Étape 2 : Récupérer les données de surcouche (sans bloquer si elles manquent).
+	if s.Overlay == nil {
+		return drivers, nil
+	}
+	extras, err := s.Overlay.Drivers(season)
+	if err != nil {
+		return drivers, nil
+	}
+
+	// Étape 3 : Fusionner les champs propres au site sur les pilotes de l'API.
+	return mergeDrivers(drivers, extras), nil
+}
+
+// Constructors
+// ------------
+// Objectif :
+//   - Récupérer toutes les pages de /f1/{season}/constructors depuis l'API.
+//   - Compléter chaque écurie avec les champs de la surcouche (logo, image, couleur).
+//   - Retourner ErrSeasonNotFound si l'API ne connaît aucune écurie pour la saison.
+func (s *ErgastSource) Constructors(season string) ([]models.Constructor, error) {
+
+	// Étape 1 : Parcourir toutes les pages de la table des écuries.
+	var constructors []models.Constructor
+	err := s.fetchAll(season, "constructors", func(data models.MRData) int {
+		if data.ConstructorTable == nil {
+			return 0
+		}
+		constructors = append(constructors, data.ConstructorTable.Constructors...)
+		return len(data.ConstructorTable.Constructors)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(constructors) == 0 {
+		return nil, ErrSeasonNotFound
+	}
+
+	// Étape 2 : Récupérer les données de surcouche (sans bloquer si elles manquent).
+	if s.Overlay == nil {
+		return constructors, nil
+	}
+	extras, err := s.Overlay.Constructors(season)
+	if err != nil {
+		return constructors, nil
+	}
+
+	// Étape 3 : Fusionner les champs propres au site sur les écuries de l'API.
+	return mergeConstructors(constructors, extras), nil
+}
+
+// fetchAll
+// --------
+// Objectif :
+//   - Interroger /f1/{season}/{resource}.json page par page avec limit/offset.
+//   - Transmettre chaque enveloppe MRData décodée à collect, qui retourne le nombre d'éléments lus.
+//   - S'arrêter quand le total annoncé par l'API est atteint ou qu'une page est vide.
+func (s *ErgastSource) fetchAll(season, resource string, collect func(models.MRData) int) error {
+	pageSize := s.PageSize
+	if pageSize <= 0 || pageSize > ergastPageSize {
+		pageSize = ergastPageSize
+	}
+
+	offset := 0
+	for {
+		// Étape 1 : Récupérer et décoder une page.
+		data, err := s.fetchPage(season, resource, pageSize, offset)
+		if err != nil {
+			return err
+		}
+
+		// Étape 2 : Transmettre les éléments et avancer l'offset.
+		count := collect(data)
+		offset += count
+
+		// Étape 3 : Arrêter si la page est vide ou si le total est atteint.
+		total, err := strconv.Atoi(data.Total)
+		if err != nil {
+			return fmt.Errorf("total invalide dans la réponse Ergast (%q): %w", data.Total, err)
+		}
+		if count == 0 || offset >= total {
+			return nil
+		}
+	}
+}
+
+// fetchPage
+// Effectue une requête GET vers l'API Ergast et décode l'enveloppe MRData.
+func (s *ErgastSource) fetchPage(season, resource string, limit, offset int) (models.MRData, error) {
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("offset", strconv.Itoa(offset))
+	endpoint := fmt.Sprintf("%s/f1/%s/%s.json?%s", strings.TrimRight(s.BaseURL, "/"), url.PathEscape(season), resource, params.Encode())
+
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return models.MRData{}, fmt.Errorf("erreur requête Ergast %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return models.MRData{}, fmt.Errorf("réponse Ergast inattendue %s: %s", endpoint, resp.Status)
+	}
+
+	var response models.F1Response
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return models.MRData{}, fmt.Errorf("erreur décodage JSON Ergast: %w", err)
+	}
+	return response.MRData, nil
+}
+
+// mergeDrivers
+// Complète les pilotes de l'API avec les champs propres au site et ajoute les pilotes absents de l'API.
+func mergeDrivers(drivers, extras []models.Driver) []models.Driver {
+	extraByID := make(map[string]models.Driver, len(extras))
+	for _, extra := range extras {
+		extraByID[extra.DriverID] = extra
+	}
+
+	merged := make([]models.Driver, 0, len(drivers)+len(extras))
+	seen := make(map[string]bool, len(drivers))
+	for _, driver := range drivers {
+		if extra, ok := extraByID[driver.DriverID]; ok {
+			driver.Image = extra.Image
+			driver.Team = extra.Team
+			driver.ConstructorID = extra.ConstructorID
+			driver.DriverType = extra.DriverType
+		}
+		seen[driver.DriverID] = true
+		merged = append(merged, driver)
+	}
+
+	// Les pilotes d'essai et de réserve ne figurent pas dans l'API : on les conserve.
+	for _, extra := range extras {
+		if !seen[extra.DriverID] {
+			merged = append(merged, extra)
+		}
+	}
+	return merged
+}
+
+// mergeConstructors
+// Complète les écuries de l'API avec les champs propres au site (logo, image, couleur, motoriste).
+func mergeConstructors(constructors, extras []models.Constructor) []models.Constructor {
+	extraByID := make(map[string]models.Constructor, len(extras))
+	for _, extra := range extras {
+		extraByID[extra.ConstructorID] = extra
+	}
+
+	merged := make([]models.Constructor, 0, len(constructors))
+	for _, constructor := range constructors {
+		if extra, ok := extraByID[constructor.ConstructorID]; ok {
+			constructor.Icon = extra.Icon
+			constructor.Image = extra.Image
+			constructor.TeamColor = extra.TeamColor
+			constructor.Engine = extra.Engine
+		}
+		merged = append(merged, constructor)
+	}
+	return merged
+}
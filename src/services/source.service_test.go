@@ -0,0 +1,194 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"f1-app/models"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// stubSource
+// Surcouche de test : pilotes et écuries fixes pour toutes les saisons.
+type stubSource struct {
+	drivers      []models.Driver
+	constructors []models.Constructor
+}
+
+func (s stubSource) Seasons() ([]string, error)                        { return []string{"2025"}, nil }
+func (s stubSource) Drivers(string) ([]models.Driver, error)           { return s.drivers, nil }
+func (s stubSource) Constructors(string) ([]models.Constructor, error) { return s.constructors, nil }
+
+// ergastStandIn
+// Serveur Ergast de test : découpe drivers et constructors selon limit/offset et enregistre les pages demandées.
+type ergastStandIn struct {
+	drivers      []models.Driver
+	constructors []models.Constructor
+	total        string // total annoncé (vide : nombre réel d'éléments)
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *ergastStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.Path+"?limit="+strconv.Itoa(limit)+"&offset="+strconv.Itoa(offset))
+	s.mu.Unlock()
+
+	data := models.MRData{Limit: strconv.Itoa(limit), Offset: strconv.Itoa(offset)}
+	page := func(count int) (int, int) {
+		start, end := ergastPageBounds(count, limit, offset)
+		data.Total = s.total
+		if data.Total == "" {
+			data.Total = strconv.Itoa(count)
+		}
+		return start, end
+	}
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/drivers.json"):
+		start, end := page(len(s.drivers))
+		data.DriverTable = &models.DriverTable{Season: "2025", Drivers: s.drivers[start:end]}
+	case strings.HasSuffix(r.URL.Path, "/constructors.json"):
+		start, end := page(len(s.constructors))
+		data.ConstructorTable = &models.ConstructorTable{Season: "2025", Constructors: s.constructors[start:end]}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(models.F1Response{MRData: data})
+}
+
+// newTestErgastSource
+// Source Ergast pointant vers le serveur de test, avec des pages de pageSize éléments.
+func newTestErgastSource(t *testing.T, handler http.Handler, pageSize int, overlay DriverSource) *ErgastSource {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	source := NewErgastSource(server.URL)
+	source.PageSize = pageSize
+	source.Overlay = overlay
+	return source
+}
+
+func TestErgastSourceDriversFetchesEveryPage(t *testing.T) {
+	standIn := &ergastStandIn{}
+	for _, id := range []string{"albon", "alonso", "bearman", "gasly", "norris"} {
+		standIn.drivers = append(standIn.drivers, models.Driver{DriverID: id, GivenName: id})
+	}
+	source := newTestErgastSource(t, standIn, 2, nil)
+
+	drivers, err := source.Drivers("2025")
+	if err != nil {
+		t.Fatalf("Drivers: erreur inattendue: %v", err)
+	}
+	if len(drivers) != 5 {
+		t.Fatalf("Drivers: %d pilotes, 5 attendus", len(drivers))
+	}
+	for i, driver := range drivers {
+		if driver.DriverID != standIn.drivers[i].DriverID {
+			t.Errorf("pilote %d = %q, %q attendu (ordre de l'API)", i, driver.DriverID, standIn.drivers[i].DriverID)
+		}
+	}
+	want := []string{
+		"/f1/2025/drivers.json?limit=2&offset=0",
+		"/f1/2025/drivers.json?limit=2&offset=2",
+		"/f1/2025/drivers.json?limit=2&offset=4",
+	}
+	if strings.Join(standIn.requests, " ") != strings.Join(want, " ") {
+		t.Errorf("pages demandées = %v, %v attendues", standIn.requests, want)
+	}
+}
+
+func TestErgastSourceDriversMergesOverlay(t *testing.T) {
+	standIn := &ergastStandIn{drivers: []models.Driver{
+		{DriverID: "albon", GivenName: "Alexander", FamilyName: "Albon"},
+		{DriverID: "sainz", GivenName: "Carlos", FamilyName: "Sainz"},
+	}}
+	overlay := stubSource{drivers: []models.Driver{
+		{DriverID: "albon", Image: "albon.webp", Team: "Williams", ConstructorID: "williams", DriverType: "Race Driver"},
+		{DriverID: "browning", GivenName: "Luke", Team: "Williams", ConstructorID: "williams", DriverType: "Test Driver"},
+	}}
+	source := newTestErgastSource(t, standIn, 100, overlay)
+
+	drivers, err := source.Drivers("2025")
+	if err != nil {
+		t.Fatalf("Drivers: erreur inattendue: %v", err)
+	}
+	if len(drivers) != 3 {
+		t.Fatalf("Drivers: %d pilotes, 3 attendus (2 de l'API + 1 de la surcouche)", len(drivers))
+	}
+	albon := drivers[0]
+	if albon.FamilyName != "Albon" || albon.Image != "albon.webp" || albon.ConstructorID != "williams" || albon.DriverType != "Race Driver" {
+		t.Errorf("albon mal fusionné: %+v", albon)
+	}
+	if drivers[1].DriverID != "sainz" || drivers[1].ConstructorID != "" {
+		t.Errorf("sainz (absent de la surcouche) modifié: %+v", drivers[1])
+	}
+	if drivers[2].DriverID != "browning" {
+		t.Errorf("pilote de la surcouche absent de l'API non ajouté en fin de liste: %+v", drivers[2])
+	}
+}
+
+func TestErgastSourceConstructorsMergesOverlay(t *testing.T) {
+	standIn := &ergastStandIn{constructors: []models.Constructor{
+		{ConstructorID: "ferrari", Name: "Ferrari"},
+		{ConstructorID: "haas", Name: "Haas F1 Team"},
+		{ConstructorID: "williams", Name: "Williams"},
+	}}
+	overlay := stubSource{constructors: []models.Constructor{
+		{ConstructorID: "haas", Icon: "haas.webp", TeamColor: "#B6BABD", Engine: "Ferrari"},
+	}}
+	source := newTestErgastSource(t, standIn, 2, overlay)
+
+	constructors, err := source.Constructors("2025")
+	if err != nil {
+		t.Fatalf("Constructors: erreur inattendue: %v", err)
+	}
+	if len(constructors) != 3 || len(standIn.requests) != 2 {
+		t.Fatalf("Constructors: %d écuries en %d pages, 3 écuries en 2 pages attendues", len(constructors), len(standIn.requests))
+	}
+	haas := constructors[1]
+	if haas.Name != "Haas F1 Team" || haas.Icon != "haas.webp" || haas.TeamColor != "#B6BABD" || haas.Engine != "Ferrari" {
+		t.Errorf("haas mal fusionné: %+v", haas)
+	}
+}
+
+func TestErgastSourceRejectsNon200(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "maintenance", http.StatusServiceUnavailable)
+	})
+	source := newTestErgastSource(t, handler, 100, nil)
+
+	_, err := source.Drivers("2025")
+	if err == nil {
+		t.Fatal("Drivers: erreur attendue pour une réponse 503")
+	}
+	if errors.Is(err, ErrSeasonNotFound) || !strings.Contains(err.Error(), "503") {
+		t.Errorf("Drivers: erreur %q, réponse inattendue 503 attendue", err)
+	}
+}
+
+func TestErgastSourceRejectsBadTotal(t *testing.T) {
+	standIn := &ergastStandIn{total: "beaucoup", drivers: []models.Driver{{DriverID: "albon"}}}
+	source := newTestErgastSource(t, standIn, 100, nil)
+
+	_, err := source.Drivers("2025")
+	if err == nil || !strings.Contains(err.Error(), "total invalide") {
+		t.Errorf("Drivers: erreur %v, total invalide attendu", err)
+	}
+}
+
+func TestErgastSourceEmptySeasonIsNotFound(t *testing.T) {
+	source := newTestErgastSource(t, &ergastStandIn{}, 100, nil)
+
+	if _, err := source.Drivers("1949"); !errors.Is(err, ErrSeasonNotFound) {
+		t.Errorf("Drivers: erreur %v, ErrSeasonNotFound attendue", err)
+	}
+}
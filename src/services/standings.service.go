@@ -0,0 +1,335 @@
+package services
+
+import (
+	"f1-app/models"
+	"sort"
+	"strconv"
+)
+
+// Ordres d'affichage proposés sur les pages pilotes et écuries.
+const (
+	SortStandings = "standings"
+	SortRoster    = "roster"
+)
+
+// PointsSystem
+// Structure décrivant le barème de points d'une saison (course, sprint, bonus du meilleur tour).
+type PointsSystem struct {
+	Race       []float64
+	Sprint     []float64
+	FastestLap float64
+	// FastestLapTop limite le bonus aux pilotes classés dans les N premiers (0 : aucune limite).
+	FastestLapTop int
+}
+
+// PointsSystemFor
+// ---------------
+// Objectif :
+//   - Retourner le barème officiel de la saison demandée.
+//   - Course : 25-18-15-12-10-8-6-4-2-1 depuis 2010, barèmes historiques avant.
+//   - Sprint : 3-2-1 en 2021, 8 à 1 depuis 2022.
+//   - Meilleur tour : 1 point de 1950 à 1959, puis de 2019 à 2024 pour un pilote classé dans le top 10.
+//   - Les résultats retenus (meilleurs N résultats) et les courses à demi-points ne sont pas modélisés.
+func PointsSystemFor(season string) PointsSystem {
+	year, _ := strconv.Atoi(season)
+	system := PointsSystem{}
+
+	// Étape 1 : Barème de la course.
+	switch {
+	case year >= 2010:
+		system.Race = []float64{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}
+	case year >= 2003:
+		system.Race = []float64{10, 8, 6, 5, 4, 3, 2, 1}
+	case year >= 1991:
+		system.Race = []float64{10, 6, 4, 3, 2, 1}
+	case year >= 1961:
+		system.Race = []float64{9, 6, 4, 3, 2, 1}
+	case year == 1960:
+		system.Race = []float64{8, 6, 4, 3, 2, 1}
+	default:
+		system.Race = []float64{8, 6, 4, 3, 2}
+	}
+
+	// Étape 2 : Barème du sprint.
+	switch {
+	case year >= 2022:
+		system.Sprint = []float64{8, 7, 6, 5, 4, 3, 2, 1}
+	case year == 2021:
+		system.Sprint = []float64{3, 2, 1}
+	}
+
+	// Étape 3 : Bonus du meilleur tour.
+	switch {
+	case year >= 2019 && year <= 2024:
+		system.FastestLap = 1
+		system.FastestLapTop = 10
+	case year > 0 && year <= 1959:
+		system.FastestLap = 1
+	}
+
+	return system
+}
+
+// resultPoints
+// Calcule les points d'un résultat à partir de sa position (barème fourni) et, en course, du bonus du meilleur tour.
+func (p PointsSystem) resultPoints(result models.RaceResult, table []float64, withFastestLap bool) float64 {
+	points := 0.0
+	position, classified := classifiedPosition(result)
+	if classified && position <= len(table) {
+		points = table[position-1]
+	}
+
+	if withFastestLap && p.FastestLap > 0 && result.FastestLap != nil && result.FastestLap.Rank == "1" {
+		if p.FastestLapTop == 0 || (classified && position <= p.FastestLapTop) {
+			points += p.FastestLap
+		}
+	}
+	return points
+}
+
+// classifiedPosition
+// Retourne la position d'un pilote classé (positionText numérique) ; faux pour un abandon, une disqualification...
+func classifiedPosition(result models.RaceResult) (int, bool) {
+	position, err := strconv.Atoi(result.PositionText)
+	if err != nil || position < 1 {
+		return 0, false
+	}
+	return position, true
+}
+
+// standingTally
+// Cumul des points et des arrivées en course d'un pilote ou d'une écurie (utilisé pour le départage).
+type standingTally struct {
+	id          string
+	name        string
+	driver      models.Driver
+	constructor models.Constructor
+	points      float64
+	finishes    []int
+}
+
+// record
+// Comptabilise une arrivée en course à la position donnée (finishes[0] = nombre de victoires).
+func (t *standingTally) record(position int) {
+	for len(t.finishes) < position {
+		t.finishes = append(t.finishes, 0)
+	}
+	t.finishes[position-1]++
+}
+
+// wins
+// Retourne le nombre de victoires en course.
+func (t *standingTally) wins() int {
+	if len(t.finishes) == 0 {
+		return 0
+	}
+	return t.finishes[0]
+}
+
+// ahead
+// Indique si a précède b : plus de points, puis départage au nombre de victoires, de deuxièmes places, etc.
+func ahead(a, b *standingTally) bool {
+	if a.points != b.points {
+		return a.points > b.points
+	}
+	for i := 0; i < len(a.finishes) || i < len(b.finishes); i++ {
+		countA, countB := 0, 0
+		if i < len(a.finishes) {
+			countA = a.finishes[i]
+		}
+		if i < len(b.finishes) {
+			countB = b.finishes[i]
+		}
+		if countA != countB {
+			return countA > countB
+		}
+	}
+	if a.name != b.name {
+		return a.name < b.name
+	}
+	return a.id < b.id
+}
+
+// rankTallies
+// Trie les cumuls dans l'ordre du championnat.
+func rankTallies(tallies map[string]*standingTally) []*standingTally {
+	ranked := make([]*standingTally, 0, len(tallies))
+	for _, tally := range tallies {
+		ranked = append(ranked, tally)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return ahead(ranked[i], ranked[j])
+	})
+	return ranked
+}
+
+// ComputeStandings
+// ----------------
+// Objectif :
+//   - Additionner les points de chaque pilote et de chaque écurie sur les courses et sprints de la saison.
+//   - Appliquer le barème de la saison (PointsSystemFor) plutôt que les points stockés.
+//   - Classer par points puis par nombre de victoires, de deuxièmes places, etc. (départage Ergast/FIA).
+//   - Calculer l'écart de points avec le leader.
+func ComputeStandings(season string, rounds map[string]models.RoundResults, driversByID map[string]models.Driver, constructorsByID map[string]models.Constructor) ([]models.DriverStanding, []models.ConstructorStanding) {
+	system := PointsSystemFor(season)
+	driverTallies := map[string]*standingTally{}
+	constructorTallies := map[string]*standingTally{}
+
+	// Étape 1 : Retrouver (ou créer) le cumul d'un pilote et de son écurie pour un résultat.
+	tallies := func(result models.RaceResult) (*standingTally, *standingTally) {
+		driver, ok := driverTallies[result.Driver.DriverID]
+		if !ok {
+			known := enrichDriver(result.Driver, driversByID)
+			driver = &standingTally{id: known.DriverID, name: known.FamilyName + " " + known.GivenName, driver: known}
+			driverTallies[known.DriverID] = driver
+		}
+		constructor, ok := constructorTallies[result.Constructor.ConstructorID]
+		if !ok {
+			known := enrichConstructor(result.Constructor, constructorsByID)
+			constructor = &standingTally{id: known.ConstructorID, name: known.Name, constructor: known}
+			constructorTallies[known.ConstructorID] = constructor
+		}
+		return driver, constructor
+	}
+
+	// Étape 2 : Cumuler les points de course (avec bonus) et de sprint, et les arrivées en course.
+	for _, round := range rounds {
+		for _, result := range round.Race {
+			driver, constructor := tallies(result)
+			points := system.resultPoints(result, system.Race, true)
+			driver.points += points
+			constructor.points += points
+			if position, ok := classifiedPosition(result); ok {
+				driver.record(position)
+				constructor.record(position)
+			}
+		}
+		for _, result := range round.Sprint {
+			driver, constructor := tallies(result)
+			points := system.resultPoints(result, system.Sprint, false)
+			driver.points += points
+			constructor.points += points
+		}
+	}
+
+	// Étape 3 : Classer les pilotes.
+	driverStandings := []models.DriverStanding{}
+	rankedDrivers := rankTallies(driverTallies)
+	for i, tally := range rankedDrivers {
+		driverStandings = append(driverStandings, models.DriverStanding{
+			Position: i + 1,
+			Driver:   tally.driver,
+			Points:   tally.points,
+			Wins:     tally.wins(),
+			Gap:      rankedDrivers[0].points - tally.points,
+		})
+	}
+
+	// Étape 4 : Classer les écuries.
+	constructorStandings := []models.ConstructorStanding{}
+	rankedConstructors := rankTallies(constructorTallies)
+	for i, tally := range rankedConstructors {
+		constructorStandings = append(constructorStandings, models.ConstructorStanding{
+			Position:    i + 1,
+			Constructor: tally.constructor,
+			Points:      tally.points,
+			Wins:        tally.wins(),
+			Gap:         rankedConstructors[0].points - tally.points,
+		})
+	}
+
+	return driverStandings, constructorStandings
+}
+
+// seasonStandings
+// Classements d'une saison calculés depuis ses résultats, indexés par identifiant, avec l'empreinte des résultats
+// (revérifications de l'index de la saison).
+type seasonStandings struct {
+	drivers          []models.DriverStanding
+	constructors     []models.ConstructorStanding
+	driversByID      map[string]*models.DriverStanding
+	constructorsByID map[string]*models.ConstructorStanding
+	fingerprint      string
+}
+
+// GetSeasonStandings
+// ------------------
+// Objectif :
+//   - Retourner les classements pilotes et constructeurs de la saison (partagés en lecture seule).
+//   - Les calculer à la première demande (voir seasonIndex.standingsOf) puis les conserver avec l'index de la
+//     saison : mêmes revérifications en arrière-plan et même invalidation que les pilotes et écuries.
+func GetSeasonStandings(season string) ([]models.DriverStanding, []models.ConstructorStanding, error) {
+	standings, err := seasonStandingsFor(season)
+	if err != nil {
+		return nil, nil, err
+	}
+	return standings.drivers, standings.constructors, nil
+}
+
+// seasonStandingsFor
+// Retourne les classements de la saison conservés avec son index.
+func seasonStandingsFor(season string) (*seasonStandings, error) {
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, err
+	}
+	return index.standingsOf(season)
+}
+
+// computeSeasonStandings
+// ----------------------
+// Objectif :
+//   - Charger les résultats de course et de sprint de la saison depuis la source configurée.
+//   - Calculer les classements (ComputeStandings) avec les fiches de l'index de la saison et les indexer.
+//   - Calculer l'empreinte des résultats, comparée lors des revérifications de l'index.
+func computeSeasonStandings(season string, index *seasonIndex) (*seasonStandings, error) {
+
+	// Étape 1 : Récupérer les résultats de toutes les manches.
+	rounds, err := resultSource.SeasonResults(season)
+	if err != nil {
+		return nil, err
+	}
+
+	// Étape 2 : Calculer les classements avec les fiches de la saison.
+	drivers, constructors := ComputeStandings(season, rounds, index.driversByID, index.constructorsByID)
+	standings := &seasonStandings{
+		drivers:          drivers,
+		constructors:     constructors,
+		driversByID:      make(map[string]*models.DriverStanding, len(drivers)),
+		constructorsByID: make(map[string]*models.ConstructorStanding, len(constructors)),
+	}
+	for i := range drivers {
+		standings.driversByID[drivers[i].Driver.DriverID] = &drivers[i]
+	}
+	for i := range constructors {
+		standings.constructorsByID[constructors[i].Constructor.ConstructorID] = &constructors[i]
+	}
+
+	// Étape 3 : Calculer l'empreinte des résultats.
+	fingerprint, err := dataFingerprint(rounds)
+	if err != nil {
+		return nil, err
+	}
+	standings.fingerprint = fingerprint
+	return standings, nil
+}
+
+// getDriverStandingsByID
+// Retourne le classement pilotes indexé par identifiant (vide si les résultats sont indisponibles).
+func getDriverStandingsByID(season string) map[string]*models.DriverStanding {
+	standings, err := seasonStandingsFor(season)
+	if err != nil {
+		return map[string]*models.DriverStanding{}
+	}
+	return standings.driversByID
+}
+
+// getConstructorStandingsByID
+// Retourne le classement constructeurs indexé par identifiant (vide si les résultats sont indisponibles).
+func getConstructorStandingsByID(season string) map[string]*models.ConstructorStanding {
+	standings, err := seasonStandingsFor(season)
+	if err != nil {
+		return map[string]*models.ConstructorStanding{}
+	}
+	return standings.constructorsByID
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"f1-app/models"
+	"slices"
+	"testing"
+)
+
+// raceResult
+// Résultat de test : pilote, écurie, position affichée (positionText) et rang du meilleur tour (vide : aucun).
+func raceResult(driverID, constructorID, position, fastestLapRank string) models.RaceResult {
+	result := models.RaceResult{
+		PositionText: position,
+		Driver:       models.Driver{DriverID: driverID, FamilyName: driverID},
+		Constructor:  models.Constructor{ConstructorID: constructorID, Name: constructorID},
+	}
+	if fastestLapRank != "" {
+		result.FastestLap = &models.FastestLap{Rank: fastestLapRank}
+	}
+	return result
+}
+
+func TestPointsSystemFor(t *testing.T) {
+	tests := []struct {
+		season        string
+		race          []float64
+		sprint        []float64
+		fastestLap    float64
+		fastestLapTop int
+	}{
+		{"2025", []float64{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}, []float64{8, 7, 6, 5, 4, 3, 2, 1}, 0, 0},
+		{"2024", []float64{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}, []float64{8, 7, 6, 5, 4, 3, 2, 1}, 1, 10},
+		{"2021", []float64{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}, []float64{3, 2, 1}, 1, 10},
+		{"2019", []float64{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}, nil, 1, 10},
+		{"2018", []float64{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}, nil, 0, 0},
+		{"2009", []float64{10, 8, 6, 5, 4, 3, 2, 1}, nil, 0, 0},
+		{"1995", []float64{10, 6, 4, 3, 2, 1}, nil, 0, 0},
+		{"1975", []float64{9, 6, 4, 3, 2, 1}, nil, 0, 0},
+		{"1960", []float64{8, 6, 4, 3, 2, 1}, nil, 0, 0},
+		{"1955", []float64{8, 6, 4, 3, 2}, nil, 1, 0},
+	}
+	for _, test := range tests {
+		system := PointsSystemFor(test.season)
+		if !slices.Equal(system.Race, test.race) || !slices.Equal(system.Sprint, test.sprint) {
+			t.Errorf("saison %s : course %v sprint %v, course %v sprint %v attendus", test.season, system.Race, system.Sprint, test.race, test.sprint)
+		}
+		if system.FastestLap != test.fastestLap || system.FastestLapTop != test.fastestLapTop {
+			t.Errorf("saison %s : meilleur tour %v (top %d), %v (top %d) attendu", test.season, system.FastestLap, system.FastestLapTop, test.fastestLap, test.fastestLapTop)
+		}
+	}
+}
+
+func TestComputeStandingsFastestLap(t *testing.T) {
+	tests := []struct {
+		name     string
+		season   string
+		race     []models.RaceResult
+		sprint   []models.RaceResult
+		expected map[string]float64
+	}{
+		{
+			name:     "bonus pour un pilote du top 10 (2024)",
+			season:   "2024",
+			race:     []models.RaceResult{raceResult("norris", "mclaren", "1", "1"), raceResult("piastri", "mclaren", "2", "")},
+			expected: map[string]float64{"norris": 26, "piastri": 18},
+		},
+		{
+			name:     "aucun bonus hors du top 10 (2024)",
+			season:   "2024",
+			race:     []models.RaceResult{raceResult("norris", "mclaren", "1", ""), raceResult("ocon", "alpine", "11", "1")},
+			expected: map[string]float64{"norris": 25, "ocon": 0},
+		},
+		{
+			name:     "aucun bonus pour un abandon (2024)",
+			season:   "2024",
+			race:     []models.RaceResult{raceResult("norris", "mclaren", "1", ""), raceResult("ocon", "alpine", "R", "1")},
+			expected: map[string]float64{"norris": 25, "ocon": 0},
+		},
+		{
+			name:     "bonus supprimé (2025)",
+			season:   "2025",
+			race:     []models.RaceResult{raceResult("norris", "mclaren", "1", "1")},
+			expected: map[string]float64{"norris": 25},
+		},
+		{
+			name:     "bonus sans condition de place (1955)",
+			season:   "1955",
+			race:     []models.RaceResult{raceResult("fangio", "mercedes", "1", ""), raceResult("moss", "maserati", "7", "1")},
+			expected: map[string]float64{"fangio": 8, "moss": 1},
+		},
+		{
+			name:     "sprint sans bonus du meilleur tour (2024)",
+			season:   "2024",
+			sprint:   []models.RaceResult{raceResult("norris", "mclaren", "1", "1"), raceResult("piastri", "mclaren", "2", "")},
+			expected: map[string]float64{"norris": 8, "piastri": 7},
+		},
+	}
+	for _, test := range tests {
+		rounds := map[string]models.RoundResults{"1": {Race: test.race, Sprint: test.sprint}}
+		drivers, _ := ComputeStandings(test.season, rounds, nil, nil)
+		if len(drivers) != len(test.expected) {
+			t.Errorf("%s : %d pilotes classés, %d attendus", test.name, len(drivers), len(test.expected))
+		}
+		for _, standing := range drivers {
+			if points, ok := test.expected[standing.Driver.DriverID]; !ok || standing.Points != points {
+				t.Errorf("%s : %s a %v points, %v attendus", test.name, standing.Driver.DriverID, standing.Points, points)
+			}
+		}
+	}
+}
+
+func TestComputeStandingsTieBreak(t *testing.T) {
+	teams := map[string]string{"albon": "williams", "zhou": "sauber"}
+	tests := []struct {
+		name   string
+		rounds map[string]models.RoundResults
+		order  []string
+	}{
+		{
+			// 25 + 1 contre 18 + 8 : une victoire contre aucune.
+			name: "départage au nombre de victoires",
+			rounds: map[string]models.RoundResults{
+				"1": {Race: []models.RaceResult{raceResult("zhou", "sauber", "1", ""), raceResult("albon", "williams", "2", "")}},
+				"2": {Race: []models.RaceResult{raceResult("albon", "williams", "6", ""), raceResult("zhou", "sauber", "10", "")}},
+			},
+			order: []string{"zhou", "albon"},
+		},
+		{
+			// 18 + 12 contre 15 + 15 : aucune victoire, une deuxième place contre aucune.
+			name: "départage au nombre de deuxièmes places",
+			rounds: map[string]models.RoundResults{
+				"1": {Race: []models.RaceResult{raceResult("zhou", "sauber", "2", ""), raceResult("albon", "williams", "3", "")}},
+				"2": {Race: []models.RaceResult{raceResult("albon", "williams", "3", ""), raceResult("zhou", "sauber", "4", "")}},
+			},
+			order: []string{"zhou", "albon"},
+		},
+		{
+			// 15 + 5 contre 12 + 8 : les places en sprint ne comptent pas pour le départage.
+			name: "victoire en sprint ignorée",
+			rounds: map[string]models.RoundResults{
+				"1": {
+					Race:   []models.RaceResult{raceResult("albon", "williams", "3", ""), raceResult("zhou", "sauber", "4", "")},
+					Sprint: []models.RaceResult{raceResult("zhou", "sauber", "1", ""), raceResult("albon", "williams", "4", "")},
+				},
+			},
+			order: []string{"albon", "zhou"},
+		},
+	}
+	for _, test := range tests {
+		drivers, constructors := ComputeStandings("2025", test.rounds, nil, nil)
+		if len(drivers) != len(test.order) || len(constructors) != len(test.order) {
+			t.Fatalf("%s : %d pilotes et %d écuries classés, %d attendus", test.name, len(drivers), len(constructors), len(test.order))
+		}
+		if drivers[0].Points != drivers[1].Points {
+			t.Errorf("%s : %v et %v points, égalité attendue", test.name, drivers[0].Points, drivers[1].Points)
+		}
+		for i, driverID := range test.order {
+			if drivers[i].Driver.DriverID != driverID || drivers[i].Position != i+1 || drivers[i].Gap != 0 {
+				t.Errorf("%s : place %d = %s (position %d, écart %v), %s attendu à égalité", test.name, i+1, drivers[i].Driver.DriverID, drivers[i].Position, drivers[i].Gap, driverID)
+			}
+		}
+		for i, driverID := range test.order {
+			if constructors[i].Constructor.ConstructorID != teams[driverID] {
+				t.Errorf("%s : écurie %d = %s, %s attendue (même départage que les pilotes)", test.name, i+1, constructors[i].Constructor.ConstructorID, teams[driverID])
+			}
+		}
+	}
+}
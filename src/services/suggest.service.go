@@ -0,0 +1,177 @@
+package services
+
+import (
+	"errors"
+	"f1-app/models"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Types d'éléments proposés par l'autocomplétion.
+const (
+	SuggestDriver      = "driver"
+	SuggestConstructor = "constructor"
+)
+
+// Nombre de suggestions par défaut et maximum (paramètre limit).
+const (
+	DefaultSuggestLimit = 8
+	MaxSuggestLimit     = 20
+)
+
+// ErrInvalidSuggestLimit est retournée lorsque le paramètre limit n'est pas un entier positif.
+var ErrInvalidSuggestLimit = errors.New("paramètre limit invalide (entier positif attendu)")
+
+// suggestTrieNode
+// Nœud de l'arbre des préfixes : enfants par caractère et éléments dont une clé commence par ce préfixe.
+type suggestTrieNode struct {
+	children map[rune]*suggestTrieNode
+	entries  []int
+}
+
+// suggestEntry
+// Élément indexé : suggestion renvoyée, poids (un pilote titulaire passe avant un pilote d'essai) et clés repliées.
+type suggestEntry struct {
+	suggestion models.Suggestion
+	weight     int
+	keys       []string
+}
+
+// suggestIndex
+// Index d'autocomplétion d'une saison : éléments et arbre des préfixes (conservé avec l'index de la saison, voir seasonIndex).
+type suggestIndex struct {
+	entries []suggestEntry
+	root    *suggestTrieNode
+}
+
+// GetSuggestions
+// --------------
+// Objectif :
+//   - Proposer, pendant la saisie, les pilotes et écuries de la saison dont un mot commence par le texte saisi
+//     (sans tenir compte de la casse ni des accents : "hul" propose « Nico Hülkenberg »).
+//   - Classer les suggestions (clé identique, nom complet puis début d'un mot ; titulaires avant les autres) et en retourner au plus limit.
+//   - S'appuyer sur l'arbre des préfixes de l'index de la saison (voir seasonIndexFor), construit une fois par saison.
+func GetSuggestions(season, query, limitParam string) ([]models.Suggestion, int, error) {
+
+	// Étape 1 : Lire le nombre de suggestions demandé.
+	limit := DefaultSuggestLimit
+	if limitParam != "" {
+		value, err := strconv.Atoi(limitParam)
+		if err != nil || value < 1 {
+			return nil, http.StatusBadRequest, ErrInvalidSuggestLimit
+		}
+		limit = min(value, MaxSuggestLimit)
+	}
+
+	// Étape 2 : Récupérer l'index de la saison.
+	index, err := seasonIndexFor(season)
+	if err != nil {
+		return nil, sourceErrorStatus(err), err
+	}
+
+	// Étape 3 : Chercher le préfixe et classer les éléments trouvés.
+	return index.suggest.lookup(foldSearchText(strings.TrimSpace(query)), limit), http.StatusOK, nil
+}
+
+// newSuggestIndex
+// ---------------
+// Objectif :
+//   - Indexer les pilotes et écuries d'une saison (chargés par l'index de la saison).
+//   - Indexer chaque élément sous plusieurs clés repliées (nom complet, chaque mot du nom, code, numéro, identifiant).
+func newSuggestIndex(season string, drivers []models.Driver, constructors []models.Constructor) *suggestIndex {
+	index := &suggestIndex{root: &suggestTrieNode{}}
+	for _, driver := range drivers {
+		name := strings.TrimSpace(driver.GivenName + " " + driver.FamilyName)
+		weight := 1
+		if driver.DriverType == "" || strings.EqualFold(driver.DriverType, "Race Driver") {
+			weight = 2
+		}
+		index.add(suggestEntry{weight: weight, suggestion: models.Suggestion{
+			Kind: SuggestDriver, ID: driver.DriverID, Label: name, Detail: driver.Team,
+			URL: "/" + season + "/drivers/" + driver.DriverID,
+		}}, append(strings.Fields(name), name, driver.Code, driver.PermanentNumber, driver.DriverID)...)
+	}
+	for _, constructor := range constructors {
+		index.add(suggestEntry{weight: 2, suggestion: models.Suggestion{
+			Kind: SuggestConstructor, ID: constructor.ConstructorID, Label: constructor.Name, Detail: constructor.Nationality,
+			URL: "/" + season + "/teams/" + constructor.ConstructorID,
+		}}, append(strings.Fields(constructor.Name), constructor.Name, constructor.ConstructorID)...)
+	}
+	return index
+}
+
+// add
+// Ajoute un élément à l'index sous chacune de ses clés, repliées (les clés vides sont ignorées).
+func (index *suggestIndex) add(entry suggestEntry, keys ...string) {
+	id := len(index.entries)
+	for _, key := range keys {
+		if folded := foldSearchText(key); folded != "" {
+			entry.keys = append(entry.keys, folded)
+		}
+	}
+	index.entries = append(index.entries, entry)
+	for _, key := range entry.keys {
+		node := index.root
+		for _, r := range key {
+			child := node.children[r]
+			if child == nil {
+				if node.children == nil {
+					node.children = map[rune]*suggestTrieNode{}
+				}
+				child = &suggestTrieNode{}
+				node.children[r] = child
+			}
+			if len(child.entries) == 0 || child.entries[len(child.entries)-1] != id {
+				child.entries = append(child.entries, id)
+			}
+			node = child
+		}
+	}
+}
+
+// lookup
+// Retourne les éléments dont une clé commence par le préfixe replié, les mieux classés en premier
+// (clé identique au préfixe, libellé complet commençant par le préfixe, poids de l'élément, puis ordre alphabétique).
+func (index *suggestIndex) lookup(prefix string, limit int) []models.Suggestion {
+	suggestions := []models.Suggestion{}
+	if prefix == "" {
+		return suggestions
+	}
+	node := index.root
+	for _, r := range prefix {
+		node = node.children[r]
+		if node == nil {
+			return suggestions
+		}
+	}
+
+	// Étape 1 : Classer les éléments trouvés.
+	ids := append([]int{}, node.entries...)
+	rank := func(id int) int {
+		entry := index.entries[id]
+		rank := entry.weight
+		if slices.Contains(entry.keys, prefix) {
+			rank += 20
+		}
+		if strings.HasPrefix(foldSearchText(entry.suggestion.Label), prefix) {
+			rank += 10
+		}
+		return rank
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		left, right := rank(ids[i]), rank(ids[j])
+		if left != right {
+			return left > right
+		}
+		return index.entries[ids[i]].suggestion.Label < index.entries[ids[j]].suggestion.Label
+	})
+
+	// Étape 2 : Retourner les premières suggestions.
+	for _, id := range ids[:min(limit, len(ids))] {
+		suggestions = append(suggestions, index.entries[id].suggestion)
+	}
+	return suggestions
+}
@@ -2,6 +2,7 @@ package templates
 
 import (
 	"bytes"
+	"f1-app/helpers"
 	"fmt"
 	"html/template"
 	"log"
@@ -9,6 +10,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var listTemp *template.Template
@@ -19,9 +23,42 @@ func getFuncMap() template.FuncMap {
 	return template.FuncMap{
 		"formatDuration": func(ms int) string {
 			totalSeconds := ms / 1000
-			minutes := totalSeconds / 60
+			hours := totalSeconds / 3600
+			minutes := totalSeconds % 3600 / 60
 			seconds := totalSeconds % 60
-			return fmt.Sprintf("%d:%02d", minutes, seconds)
+			millis := ms % 1000
+			if hours > 0 {
+				return fmt.Sprintf("%d:%02d:%02d.%03d", hours, minutes, seconds, millis)
+			}
+			if minutes > 0 {
+				return fmt.Sprintf("%d:%02d.%03d", minutes, seconds, millis)
+			}
+			return fmt.Sprintf("%d.%03d", seconds, millis)
+		},
+		"formatPoints": func(points float64) string {
+			return strconv.FormatFloat(points, 'f', -1, 64)
+		},
+		"lapMillis": parseLapTime,
+		"millis": func(value string) int {
+			ms, err := strconv.Atoi(value)
+			if err != nil {
+				return 0
+			}
+			return ms
+		},
+		"formatSession": func(date, clock string) string {
+			if clock == "" {
+				day, err := time.Parse("2006-01-02", date)
+				if err != nil {
+					return date
+				}
+				return day.Format("Mon 02 Jan 2006")
+			}
+			start, err := time.Parse(time.RFC3339, date+"T"+clock)
+			if err != nil {
+				return date + " " + clock
+			}
+			return start.UTC().Format("Mon 02 Jan 2006 · 15:04 UTC")
 		},
 		"add": func(a, b int) int {
 			return a + b
@@ -29,6 +66,15 @@ func getFuncMap() template.FuncMap {
 		"sub": func(a, b int) int {
 			return a - b
 		},
+		"dict": func(pairs ...interface{}) map[string]interface{} {
+			values := make(map[string]interface{}, len(pairs)/2)
+			for i := 0; i+1 < len(pairs); i += 2 {
+				if key, ok := pairs[i].(string); ok {
+					values[key] = pairs[i+1]
+				}
+			}
+			return values
+		},
 		"iterate": func(count int) []int {
 			var items []int
 			for i := 0; i < count; i++ {
@@ -39,6 +85,25 @@ func getFuncMap() template.FuncMap {
 	}
 }
 
+// parseLapTime
+// Convertit un temps au format Ergast ("1:22.167", "1:42:06.304", "+0.895") en millisecondes (0 si invalide).
+func parseLapTime(value string) int {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "+")
+	if value == "" {
+		return 0
+	}
+
+	total := 0.0
+	for _, part := range strings.Split(value, ":") {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 {
+			return 0
+		}
+		total = total*60 + number
+	}
+	return int(total*1000 + 0.5)
+}
+
 // Load
 // Charge tous les fichiers de templates HTML au démarrage de l'application.
 func Load() {
@@ -73,6 +138,12 @@ func Load() {
 // RenderTemplate
 // Exécute un template et écrit la réponse HTTP. En cas d'erreur, redirige vers la page d'erreur.
 func RenderTemplate(w http.ResponseWriter, r *http.Request, name string, data interface{}) {
+	RenderTemplateWithStatus(w, r, name, data, http.StatusOK)
+}
+
+// RenderTemplateWithStatus
+// Identique à RenderTemplate, mais répond avec le code HTTP fourni (ex : 404 pour la page d'erreur).
+func RenderTemplateWithStatus(w http.ResponseWriter, r *http.Request, name string, data interface{}, status int) {
 	// Étape 1 : Exécuter le template dans un buffer (sans envoyer au client).
 	var buffer bytes.Buffer
 
@@ -86,6 +157,15 @@ func RenderTemplate(w http.ResponseWriter, r *http.Request, name string, data in
 		return
 	}
 
-	// Étape 3 : Envoyer le buffer au client en réponse HTTP.
-	_, _ = buffer.WriteTo(w)
+	// Étape 3 : Ajouter le jeton CSRF du visiteur aux formulaires POST de la page.
+	page := buffer.Bytes()
+	if token := helpers.CSRFTokenFromContext(r.Context()); token != "" {
+		page = helpers.InjectCSRFToken(page, token)
+	}
+
+	// Étape 4 : Envoyer le code HTTP puis la page au client.
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+	}
+	_, _ = w.Write(page)
 }